@@ -67,8 +67,10 @@ func ServiceObject(namespace string, appKubernetesPartOfValue string) *v1.Servic
 // Annotation to generate RBAC roles to read and modify services
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;watch;list;create;update;delete
 
-func CreateServiceController(ctx context.Context, mgr ctrl.Manager) (*serviceReconciler, error) {
-	return newServiceReconciler(ctx, mgr)
+// CreateServiceController creates a controller that manages the metrics service.
+// In read-only mode, all changes to the service are only sent as dry-run requests.
+func CreateServiceController(ctx context.Context, mgr ctrl.Manager, readOnly bool) (*serviceReconciler, error) {
+	return newServiceReconciler(ctx, mgr, readOnly)
 }
 
 func (r *serviceReconciler) Name() string {
@@ -126,7 +128,7 @@ func getOperatorDeployment(ctx context.Context, namespace string, apiReader clie
 	return &deployment, nil
 }
 
-func newServiceReconciler(ctx context.Context, mgr ctrl.Manager) (*serviceReconciler, error) {
+func newServiceReconciler(ctx context.Context, mgr ctrl.Manager, readOnly bool) (*serviceReconciler, error) {
 	logger := ctrl.Log.WithName("controllers").WithName("Resources")
 	namespace, err := common.GetOperatorNamespace(logger)
 	if err != nil {
@@ -138,8 +140,13 @@ func newServiceReconciler(ctx context.Context, mgr ctrl.Manager) (*serviceReconc
 		return nil, fmt.Errorf("in newServiceReconciler: %w", err)
 	}
 
+	apiClient := mgr.GetClient()
+	if readOnly {
+		apiClient = client.NewDryRunClient(apiClient)
+	}
+
 	reconciler := &serviceReconciler{
-		client:           mgr.GetClient(),
+		client:           apiClient,
 		log:              logger,
 		serviceNamespace: namespace,
 		deployment:       deployment,
@@ -28,12 +28,20 @@ import (
 // Need to watch CRDs
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=list;watch
 
-func CreateAndStartReconciler(ctx context.Context, mgr controllerruntime.Manager) error {
+// Options configures the SSP reconciler.
+type Options struct {
+	// ReadOnly makes the operator send all changes to the resources it manages as dry-run requests.
+	ReadOnly bool
+	// UnknownBundleKindPolicy specifies how resources of unsupported kinds in the template bundle are handled.
+	UnknownBundleKindPolicy template_bundle.UnknownKindPolicy
+}
+
+func CreateAndStartReconciler(ctx context.Context, mgr controllerruntime.Manager, options Options) error {
 	mgrCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
 	mgrCtx = logr.NewContext(mgrCtx, mgr.GetLogger())
 
-	if err := setupManager(mgrCtx, cancel, mgr); err != nil {
+	if err := setupManager(mgrCtx, cancel, mgr, options); err != nil {
 		return fmt.Errorf("failed to setup manager: %w", err)
 	}
 
@@ -45,17 +53,22 @@ func CreateAndStartReconciler(ctx context.Context, mgr controllerruntime.Manager
 	return nil
 }
 
-func setupManager(ctx context.Context, cancel context.CancelFunc, mgr controllerruntime.Manager) error {
+func setupManager(ctx context.Context, cancel context.CancelFunc, mgr controllerruntime.Manager, options Options) error {
 	runningOnOpenShift, err := common.RunningOnOpenshift(ctx, mgr.GetAPIReader())
 	if err != nil {
 		return fmt.Errorf("failed to check if running on openshift: %w", err)
 	}
 
 	templatesFile := filepath.Join(templateBundleDir, "common-templates-"+common_templates.Version+".yaml")
-	templatesBundle, err := template_bundle.ReadBundle(templatesFile)
+	templatesBundle, err := template_bundle.ReadBundle(templatesFile, options.UnknownBundleKindPolicy)
 	if err != nil {
 		return fmt.Errorf("failed to read template bundle: %w", err)
 	}
+	if len(templatesBundle.UnknownKinds) > 0 {
+		mgr.GetLogger().Info("Skipped resources of unsupported kinds in template bundle",
+			"kinds", templatesBundle.UnknownKinds,
+		)
+	}
 
 	vmConsoleProxyBundlePath := vm_console_proxy_bundle.GetBundlePath()
 	vmConsoleProxyBundle, err := vm_console_proxy_bundle.ReadBundle(vmConsoleProxyBundlePath)
@@ -120,7 +133,7 @@ func setupManager(ctx context.Context, cancel context.CancelFunc, mgr controller
 		return fmt.Errorf("failed to add CRD watch to manager: %w", err)
 	}
 
-	serviceController, err := CreateServiceController(ctx, mgr)
+	serviceController, err := CreateServiceController(ctx, mgr, options.ReadOnly)
 	if err != nil {
 		return fmt.Errorf("failed to create service controller: %w", err)
 	}
@@ -129,7 +142,7 @@ func setupManager(ctx context.Context, cancel context.CancelFunc, mgr controller
 		return fmt.Errorf("error adding service controller: %w", err)
 	}
 
-	webhookConfigController := NewWebhookConfigurationController(mgr.GetClient())
+	webhookConfigController := NewWebhookConfigurationController(mgr.GetClient(), options.ReadOnly)
 	if err = mgr.Add(getRunnable(mgr, webhookConfigController)); err != nil {
 		return fmt.Errorf("error adding webhook configuration controller: %w", err)
 	}
@@ -147,7 +160,15 @@ func setupManager(ctx context.Context, cancel context.CancelFunc, mgr controller
 		mgr.GetLogger().Info("[vm controller] added")
 	}
 
-	reconciler := NewSspReconciler(mgr.GetClient(), mgr.GetAPIReader(), infrastructureTopology, sspOperands, crdWatch)
+	if options.ReadOnly {
+		mgr.GetLogger().Info("The operator is running in read-only mode. No changes will be made to resources managed by the operator.")
+	}
+
+	reconciler := NewSspReconciler(mgr.GetClient(), mgr.GetAPIReader(), infrastructureTopology, sspOperands, crdWatch, options.ReadOnly, templatesBundle.UnknownKinds)
+
+	if err = mgr.AddReadyzCheck("operands", reconciler.operandsHealthCheck); err != nil {
+		return fmt.Errorf("failed to add operands readiness check: %w", err)
+	}
 
 	return reconciler.setupController(mgr)
 }
@@ -17,11 +17,15 @@ limitations under the License.
 package controllers
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"net/http"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	osconfv1 "github.com/openshift/api/config/v1"
@@ -32,6 +36,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -39,6 +44,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -59,6 +65,23 @@ const (
 	oldFinalizerName = "finalize.ssp.kubevirt.io"
 
 	templateBundleDir = "data/common-templates-bundle/"
+
+	conditionReadOnly conditionsv1.ConditionType = "ReadOnly"
+
+	conditionPaused conditionsv1.ConditionType = "Paused"
+
+	conditionUnknownBundleKinds conditionsv1.ConditionType = "UnknownBundleKinds"
+
+	conditionReconcileHistory conditionsv1.ConditionType = "ReconcileHistory"
+
+	reconcileHistorySize      = 10
+	reconcileHistorySeparator = ", "
+
+	operandReconcileTimeout = 5 * time.Minute
+
+	// Not all resources deleted during cleanup are watched,
+	// so the cleanup is retried periodically until all of them are gone.
+	cleanupRequeueInterval = 5 * time.Second
 )
 
 // List of legacy CRDs and their corresponding kinds
@@ -74,27 +97,54 @@ type sspReconciler struct {
 	uncachedReader   client.Reader
 	log              logr.Logger
 	operands         []operands.Operand
+	healthCheckers   []operands.Operand
 	lastSspSpec      ssp.SSPSpec
-	subresourceCache common.VersionCache
+	subresourceCache *common.VersionCache
 	topologyMode     osconfv1.TopologyMode
 	crdList          crd_watch.CrdList
 	areCrdsMissing   bool
+	readOnly         bool
+
+	unknownBundleKinds []string
 }
 
-func NewSspReconciler(client client.Client, uncachedReader client.Reader, infrastructureTopology osconfv1.TopologyMode, operands []operands.Operand, crdList crd_watch.CrdList) *sspReconciler {
+func NewSspReconciler(client client.Client, uncachedReader client.Reader, infrastructureTopology osconfv1.TopologyMode, operands []operands.Operand, crdList crd_watch.CrdList, readOnly bool, unknownBundleKinds []string) *sspReconciler {
+	sortedOperands := sortOperandsByPriority(operands)
 	return &sspReconciler{
 		client:           client,
 		uncachedReader:   uncachedReader,
 		log:              ctrl.Log.WithName("controllers").WithName("SSP"),
-		operands:         operands,
-		subresourceCache: common.VersionCache{},
+		operands:         sortedOperands,
+		healthCheckers:   filterHealthCheckers(sortedOperands),
+		subresourceCache: common.NewVersionCache(),
 		topologyMode:     infrastructureTopology,
 		crdList:          crdList,
+		readOnly:         readOnly,
+
+		unknownBundleKinds: unknownBundleKinds,
 	}
 }
 
 var _ reconcile.Reconciler = &sspReconciler{}
 
+func filterHealthCheckers(sspOperands []operands.Operand) []operands.Operand {
+	var result []operands.Operand
+	for _, operand := range sspOperands {
+		if _, ok := operand.(operands.HealthChecker); ok {
+			result = append(result, operand)
+		}
+	}
+	return result
+}
+
+func sortOperandsByPriority(sspOperands []operands.Operand) []operands.Operand {
+	sorted := slices.Clone(sspOperands)
+	slices.SortStableFunc(sorted, func(a, b operands.Operand) int {
+		return cmp.Compare(operands.Priority(a), operands.Priority(b))
+	})
+	return sorted
+}
+
 // +kubebuilder:rbac:groups=ssp.kubevirt.io,resources=ssps,verbs=list;watch;update
 // +kubebuilder:rbac:groups=ssp.kubevirt.io,resources=ssps/status,verbs=update
 // +kubebuilder:rbac:groups=ssp.kubevirt.io,resources=ssps/finalizers,verbs=update
@@ -162,6 +212,7 @@ func (r *sspReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ct
 		Logger:          reqLogger,
 		VersionCache:    r.subresourceCache,
 		TopologyMode:    r.topologyMode,
+		ReadOnly:        r.readOnly,
 		CrdList:         r.crdList,
 	}
 
@@ -178,10 +229,18 @@ func (r *sspReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ct
 	}
 
 	if isBeingDeleted(sspRequest.Instance) {
-		err := r.cleanup(sspRequest)
+		if r.readOnly {
+			// Resources cannot be deleted in read-only mode, so the finalizer is kept.
+			err := setReadOnlyDeletionCondition(sspRequest)
+			return ctrl.Result{}, err
+		}
+		pending, err := r.cleanup(sspRequest)
 		if err != nil {
 			return ctrl.Result{}, err
 		}
+		if pending {
+			return ctrl.Result{RequeueAfter: cleanupRequeueInterval}, nil
+		}
 		r.clearCache()
 		return ctrl.Result{}, nil
 	}
@@ -193,17 +252,18 @@ func (r *sspReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ct
 		reqLogger.Info(fmt.Sprintf("Pausing SSP operator on resource: %v/%v", instance.Namespace, instance.Name))
 		instance.Status.Paused = true
 		instance.Status.ObservedGeneration = instance.Generation
+		setPausedCondition(&instance.Status.Conditions, true)
 		err := r.client.Status().Update(ctx, instance)
 		return ctrl.Result{}, err
 	}
 
 	if r.areCrdsMissing {
-		err := updateStatusMissingCrds(sspRequest, r.crdList.MissingCrds())
+		err := updateStatusMissingCrds(sspRequest, r.operands, r.crdList.MissingCrds())
 		return ctrl.Result{}, err
 	}
 
 	sspRequest.Logger.V(1).Info("Updating CR status prior to operand reconciliation...")
-	err = preUpdateStatus(sspRequest)
+	err = preUpdateStatus(sspRequest, r.unknownBundleKinds)
 	if err != nil {
 		return handleError(sspRequest, err, sspRequest.Logger)
 	}
@@ -233,9 +293,50 @@ func (r *sspReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ct
 	return ctrl.Result{}, nil
 }
 
+// operandsHealthCheck is a healthz.Checker that calls Health of all operands
+// implementing operands.HealthChecker, for each SSP resource.
+func (r *sspReconciler) operandsHealthCheck(req *http.Request) error {
+	if len(r.healthCheckers) == 0 {
+		return nil
+	}
+
+	sspList := &ssp.SSPList{}
+	if err := r.client.List(req.Context(), sspList); err != nil {
+		return fmt.Errorf("failed to list SSP resources: %w", err)
+	}
+
+	for i := range sspList.Items {
+		instance := &sspList.Items[i]
+		if isBeingDeleted(instance) || isPaused(instance) {
+			continue
+		}
+
+		sspRequest := &common.Request{
+			Request:        reconcile.Request{NamespacedName: client.ObjectKeyFromObject(instance)},
+			Client:         r.client,
+			UncachedReader: r.uncachedReader,
+			Context:        req.Context(),
+			Instance:       instance,
+			Logger:         r.log.WithValues("ssp", client.ObjectKeyFromObject(instance)),
+			VersionCache:   common.NewVersionCache(),
+			TopologyMode:   r.topologyMode,
+			// Health checks must not modify any resources
+			ReadOnly: true,
+			CrdList:  r.crdList,
+		}
+
+		for _, operand := range r.healthCheckers {
+			if err := operand.(operands.HealthChecker).Health(sspRequest); err != nil {
+				return fmt.Errorf("operand %s is not healthy: %w", operand.Name(), err)
+			}
+		}
+	}
+	return nil
+}
+
 func (r *sspReconciler) clearCacheIfNeeded(sspObj *ssp.SSP) bool {
 	if !reflect.DeepEqual(r.lastSspSpec, sspObj.Spec) {
-		r.subresourceCache = common.VersionCache{}
+		r.subresourceCache = common.NewVersionCache()
 		r.lastSspSpec = sspObj.Spec
 		return true
 	}
@@ -244,7 +345,7 @@ func (r *sspReconciler) clearCacheIfNeeded(sspObj *ssp.SSP) bool {
 
 func (r *sspReconciler) clearCache() {
 	r.lastSspSpec = ssp.SSPSpec{}
-	r.subresourceCache = common.VersionCache{}
+	r.subresourceCache = common.NewVersionCache()
 }
 
 func isPaused(object metav1.Object) bool {
@@ -308,60 +409,42 @@ func updateSspResource(request *common.Request) error {
 	return setSspResourceDeploying(request)
 }
 
-func (r *sspReconciler) cleanup(request *common.Request) error {
+// cleanup returns true if some resources are still pending deletion.
+func (r *sspReconciler) cleanup(request *common.Request) (bool, error) {
 	if controllerutil.ContainsFinalizer(request.Instance, finalizerName) ||
 		controllerutil.ContainsFinalizer(request.Instance, oldFinalizerName) {
-		sspStatus := &request.Instance.Status
-		sspStatus.Phase = lifecycleapi.PhaseDeleting
-		sspStatus.ObservedGeneration = request.Instance.Generation
-		conditionsv1.SetStatusCondition(&sspStatus.Conditions, conditionsv1.Condition{
-			Type:    conditionsv1.ConditionAvailable,
-			Status:  v1.ConditionFalse,
-			Reason:  "Available",
-			Message: "Deleting SSP resources",
-		})
-		conditionsv1.SetStatusCondition(&sspStatus.Conditions, conditionsv1.Condition{
-			Type:    conditionsv1.ConditionProgressing,
-			Status:  v1.ConditionTrue,
-			Reason:  "Progressing",
-			Message: "Deleting SSP resources",
-		})
-		conditionsv1.SetStatusCondition(&sspStatus.Conditions, conditionsv1.Condition{
-			Type:    conditionsv1.ConditionDegraded,
-			Status:  v1.ConditionTrue,
-			Reason:  "Degraded",
-			Message: "Deleting SSP resources",
-		})
-
-		err := request.Client.Status().Update(request.Context, request.Instance)
-		if err != nil {
-			return err
+		// The status is updated only once, not on every requeue while resources are pending.
+		if err := setDeletingStatus(request); err != nil {
+			return false, err
 		}
 
 		pendingCount := 0
 		for _, operand := range r.operands {
 			cleanupResults, err := operand.Cleanup(request)
 			if err != nil {
-				return err
+				return false, err
 			}
 
+			var pendingResources []client.Object
 			for _, result := range cleanupResults {
 				if !result.Deleted {
 					pendingCount += 1
+					pendingResources = append(pendingResources, result.Resource)
 				}
 			}
+			metrics.SetSspOperatorManagedResources(operand.Name(), countResourcesByKind(pendingResources))
 		}
 
 		if pendingCount > 0 {
-			// Will retry cleanup on next reconciliation iteration
-			return nil
+			// The deletes were sent, but the resources are not removed yet.
+			// Cleanup is retried until they are gone.
+			return true, nil
 		}
 
 		controllerutil.RemoveFinalizer(request.Instance, finalizerName)
 		controllerutil.RemoveFinalizer(request.Instance, oldFinalizerName)
-		err = request.Client.Update(request.Context, request.Instance)
-		if err != nil {
-			return err
+		if err := request.Client.Update(request.Context, request.Instance); err != nil {
+			return false, err
 		}
 	}
 
@@ -371,9 +454,38 @@ func (r *sspReconciler) cleanup(request *common.Request) error {
 	if errors.IsConflict(err) || errors.IsNotFound(err) {
 		// These errors are ignored. They can happen if the CR was removed
 		// before the status update call is executed.
+		return false, nil
+	}
+	return false, err
+}
+
+func setDeletingStatus(request *common.Request) error {
+	sspStatus := &request.Instance.Status
+	if sspStatus.Phase == lifecycleapi.PhaseDeleting && sspStatus.ObservedGeneration == request.Instance.Generation {
 		return nil
 	}
-	return err
+
+	sspStatus.Phase = lifecycleapi.PhaseDeleting
+	sspStatus.ObservedGeneration = request.Instance.Generation
+	conditionsv1.SetStatusCondition(&sspStatus.Conditions, conditionsv1.Condition{
+		Type:    conditionsv1.ConditionAvailable,
+		Status:  v1.ConditionFalse,
+		Reason:  "Available",
+		Message: "Deleting SSP resources",
+	})
+	conditionsv1.SetStatusCondition(&sspStatus.Conditions, conditionsv1.Condition{
+		Type:    conditionsv1.ConditionProgressing,
+		Status:  v1.ConditionTrue,
+		Reason:  "Progressing",
+		Message: "Deleting SSP resources",
+	})
+	conditionsv1.SetStatusCondition(&sspStatus.Conditions, conditionsv1.Condition{
+		Type:    conditionsv1.ConditionDegraded,
+		Status:  v1.ConditionTrue,
+		Reason:  "Degraded",
+		Message: "Deleting SSP resources",
+	})
+	return request.Client.Status().Update(request.Context, request.Instance)
 }
 
 func pauseCRs(sspRequest *common.Request, kinds []string) error {
@@ -392,7 +504,7 @@ func pauseCRs(sspRequest *common.Request, kinds []string) error {
 			return err
 		}
 		for _, item := range crs.Items {
-			err = sspRequest.Client.Patch(sspRequest.Context, &item, client.RawPatch(types.MergePatchType, patch))
+			err = sspRequest.WriteClient().Patch(sspRequest.Context, &item, client.RawPatch(types.MergePatchType, patch))
 			if err != nil {
 				// Patching failed, maybe the CR just got removed? Log an error but keep going.
 				sspRequest.Logger.Error(err, fmt.Sprintf("Error pausing %s from namespace %s: %s",
@@ -441,18 +553,57 @@ func (r *sspReconciler) reconcileOperands(sspRequest *common.Request) ([]common.
 	allReconcileResults := make([]common.ReconcileResult, 0, len(r.operands))
 	for _, operand := range r.operands {
 		sspRequest.Logger.V(1).Info(fmt.Sprintf("Reconciling operand: %s", operand.Name()))
-		reconcileResults, err := operand.Reconcile(sspRequest)
+		reconcileResults, err := reconcileOperand(sspRequest, operand)
+		metrics.SetSspOperatorLastReconcileTimestamp(operand.Name())
+		setOperandReadyCondition(&sspRequest.Instance.Status.Conditions, operand.Name(), reconcileResults, err)
 		if err != nil {
 			sspRequest.Logger.Info(fmt.Sprintf("Operand reconciliation failed: %s", err.Error()))
 			return nil, err
 		}
 		allReconcileResults = append(allReconcileResults, reconcileResults...)
+
+		managedResources := make([]client.Object, 0, len(reconcileResults))
+		for _, result := range reconcileResults {
+			if result.OperationResult != common.OperationResultDeleted {
+				managedResources = append(managedResources, result.Resource)
+			}
+		}
+		metrics.SetSspOperatorManagedResources(operand.Name(), countResourcesByKind(managedResources))
 	}
 
 	return allReconcileResults, nil
 }
 
-func preUpdateStatus(request *common.Request) error {
+func countResourcesByKind(resources []client.Object) map[string]int {
+	counts := map[string]int{}
+	for _, resource := range resources {
+		if resource == nil {
+			continue
+		}
+		gvk, err := apiutil.GVKForObject(resource, common.Scheme)
+		if err != nil {
+			gvk = resource.GetObjectKind().GroupVersionKind()
+		}
+		counts[gvk.Kind]++
+	}
+	return counts
+}
+
+// reconcileOperand reconciles the operand with a context bounded by operandReconcileTimeout,
+// so a single slow operand cannot block the reconcile of the others indefinitely.
+func reconcileOperand(request *common.Request, operand operands.Operand) ([]common.ReconcileResult, error) {
+	parentCtx := request.Context
+	ctx, cancel := context.WithTimeout(parentCtx, operandReconcileTimeout)
+	defer func() {
+		cancel()
+		request.Context = parentCtx
+	}()
+
+	request.Context = ctx
+	return operand.Reconcile(request)
+}
+
+func preUpdateStatus(request *common.Request, unknownBundleKinds []string) error {
 	operatorVersion := common.GetOperatorVersion()
 
 	sspStatus := &request.Instance.Status
@@ -466,6 +617,10 @@ func preUpdateStatus(request *common.Request) error {
 			request.Instance.Namespace, request.Instance.Name))
 	}
 	sspStatus.Paused = false
+	setPausedCondition(&sspStatus.Conditions, false)
+
+	setReadOnlyCondition(request)
+	setUnknownBundleKindsCondition(&sspStatus.Conditions, unknownBundleKinds)
 
 	if !conditionsv1.IsStatusConditionPresentAndEqual(sspStatus.Conditions, conditionsv1.ConditionAvailable, v1.ConditionFalse) {
 		conditionsv1.SetStatusCondition(&sspStatus.Conditions, conditionsv1.Condition{
@@ -497,6 +652,68 @@ func preUpdateStatus(request *common.Request) error {
 	return request.Client.Status().Update(request.Context, request.Instance)
 }
 
+func setReadOnlyCondition(request *common.Request) {
+	sspStatus := &request.Instance.Status
+	if !request.ReadOnly {
+		conditionsv1.RemoveStatusCondition(&sspStatus.Conditions, conditionReadOnly)
+		return
+	}
+
+	conditionsv1.SetStatusCondition(&sspStatus.Conditions, conditionsv1.Condition{
+		Type:    conditionReadOnly,
+		Status:  v1.ConditionTrue,
+		Reason:  "ReadOnly",
+		Message: "The operator is running in read-only mode, changes to SSP resources are not applied",
+	})
+}
+
+func setUnknownBundleKindsCondition(conditions *[]conditionsv1.Condition, unknownKinds []string) {
+	if len(unknownKinds) == 0 {
+		conditionsv1.RemoveStatusCondition(conditions, conditionUnknownBundleKinds)
+		return
+	}
+
+	conditionsv1.SetStatusCondition(conditions, conditionsv1.Condition{
+		Type:    conditionUnknownBundleKinds,
+		Status:  v1.ConditionTrue,
+		Reason:  "UnknownBundleKinds",
+		Message: "Skipped resources of unsupported kinds in template bundle: " + strings.Join(unknownKinds, ", "),
+	})
+}
+
+const readOnlyDeletionMessage = "The SSP resource is being deleted, but the operator is running in read-only mode, so its resources are not removed"
+
+func setReadOnlyDeletionCondition(request *common.Request) error {
+	sspStatus := &request.Instance.Status
+	condition := conditionsv1.FindStatusCondition(sspStatus.Conditions, conditionReadOnly)
+	if condition != nil && condition.Status == v1.ConditionTrue && condition.Message == readOnlyDeletionMessage {
+		return nil
+	}
+
+	request.Logger.Info("SSP resource is being deleted in read-only mode, skipping cleanup of operand resources")
+	conditionsv1.SetStatusCondition(&sspStatus.Conditions, conditionsv1.Condition{
+		Type:    conditionReadOnly,
+		Status:  v1.ConditionTrue,
+		Reason:  "ReadOnly",
+		Message: readOnlyDeletionMessage,
+	})
+	return request.Client.Status().Update(request.Context, request.Instance)
+}
+
+func setPausedCondition(conditions *[]conditionsv1.Condition, paused bool) {
+	if !paused {
+		conditionsv1.RemoveStatusCondition(conditions, conditionPaused)
+		return
+	}
+
+	conditionsv1.SetStatusCondition(conditions, conditionsv1.Condition{
+		Type:    conditionPaused,
+		Status:  v1.ConditionTrue,
+		Reason:  "Paused",
+		Message: fmt.Sprintf("Reconciliation is paused by the %s annotation", ssp.OperatorPausedAnnotation),
+	})
+}
+
 func updateStatus(request *common.Request, reconcileResults []common.ReconcileResult) error {
 	notAvailable := make([]common.ReconcileResult, 0, len(reconcileResults))
 	progressing := make([]common.ReconcileResult, 0, len(reconcileResults))
@@ -597,13 +814,34 @@ func updateStatus(request *common.Request, reconcileResults []common.ReconcileRe
 		sspStatus.Phase = lifecycleapi.PhaseDeploying
 	}
 
+	switch {
+	case len(degraded) > 0:
+		appendReconcileHistory(&sspStatus.Conditions, "Degraded")
+	case len(notAvailable) > 0:
+		appendReconcileHistory(&sspStatus.Conditions, "NotAvailable")
+	case len(progressing) > 0:
+		appendReconcileHistory(&sspStatus.Conditions, "Progressing")
+	default:
+		appendReconcileHistory(&sspStatus.Conditions, "OK")
+	}
+
 	return request.Client.Status().Update(request.Context, request.Instance)
 }
 
-func updateStatusMissingCrds(request *common.Request, missingCrds []string) error {
+func updateStatusMissingCrds(request *common.Request, sspOperands []operands.Operand, missingCrds []string) error {
 	sspStatus := &request.Instance.Status
 
 	message := fmt.Sprintf("Required CRDs are missing: %s", strings.Join(missingCrds, ", "))
+
+	// No operand is reconciled while some CRDs are missing
+	for _, operand := range sspOperands {
+		conditionsv1.SetStatusCondition(&sspStatus.Conditions, conditionsv1.Condition{
+			Type:    operandReadyConditionType(operand.Name()),
+			Status:  v1.ConditionFalse,
+			Reason:  "MissingCrds",
+			Message: message,
+		})
+	}
 	conditionsv1.SetStatusCondition(&sspStatus.Conditions, conditionsv1.Condition{
 		Type:    conditionsv1.ConditionAvailable,
 		Status:  v1.ConditionFalse,
@@ -628,6 +866,90 @@ func updateStatusMissingCrds(request *common.Request, missingCrds []string) erro
 	return request.Client.Status().Update(request.Context, request.Instance)
 }
 
+// operandReadyConditionType returns the type of the condition that reports
+// readiness of the operand, for example "CommonTemplatesReady" for "common-templates".
+func operandReadyConditionType(operandName string) conditionsv1.ConditionType {
+	var sb strings.Builder
+	for _, part := range strings.Split(operandName, "-") {
+		if part == "" {
+			continue
+		}
+		sb.WriteString(strings.ToUpper(part[:1]))
+		sb.WriteString(part[1:])
+	}
+	sb.WriteString("Ready")
+	return conditionsv1.ConditionType(sb.String())
+}
+
+func setOperandReadyCondition(conditions *[]conditionsv1.Condition, operandName string, reconcileResults []common.ReconcileResult, reconcileErr error) {
+	conditionType := operandReadyConditionType(operandName)
+	if reconcileErr != nil {
+		conditionsv1.SetStatusCondition(conditions, conditionsv1.Condition{
+			Type:    conditionType,
+			Status:  v1.ConditionFalse,
+			Reason:  "ReconcileFailed",
+			Message: fmt.Sprintf("Error: %v", reconcileErr),
+		})
+		return
+	}
+
+	var lastFailed *common.ReconcileResult
+	for i := range reconcileResults {
+		if !reconcileResults[i].IsSuccess() {
+			lastFailed = &reconcileResults[i]
+		}
+	}
+
+	if lastFailed == nil {
+		conditionsv1.SetStatusCondition(conditions, conditionsv1.Condition{
+			Type:    conditionType,
+			Status:  v1.ConditionTrue,
+			Reason:  "Ready",
+			Message: fmt.Sprintf("All %s resources are ready", operandName),
+		})
+		return
+	}
+
+	var message string
+	switch status := lastFailed.Status; {
+	case status.Degraded != nil:
+		message = *status.Degraded
+	case status.NotAvailable != nil:
+		message = *status.NotAvailable
+	default:
+		message = *status.Progressing
+	}
+
+	conditionsv1.SetStatusCondition(conditions, conditionsv1.Condition{
+		Type:    conditionType,
+		Status:  v1.ConditionFalse,
+		Reason:  "NotReady",
+		Message: prefixResourceTypeAndName(message, lastFailed.Resource),
+	})
+}
+
+// appendReconcileHistory adds the outcome of the current reconciliation to the
+// ReconcileHistory condition. The condition message keeps only the last
+// reconcileHistorySize outcomes, the oldest first.
+func appendReconcileHistory(conditions *[]conditionsv1.Condition, outcome string) {
+	var history []string
+	if condition := conditionsv1.FindStatusCondition(*conditions, conditionReconcileHistory); condition != nil && condition.Message != "" {
+		history = strings.Split(condition.Message, reconcileHistorySeparator)
+	}
+
+	history = append(history, outcome)
+	if len(history) > reconcileHistorySize {
+		history = history[len(history)-reconcileHistorySize:]
+	}
+
+	conditionsv1.SetStatusCondition(conditions, conditionsv1.Condition{
+		Type:    conditionReconcileHistory,
+		Status:  v1.ConditionTrue,
+		Reason:  "ReconcileHistory",
+		Message: strings.Join(history, reconcileHistorySeparator),
+	})
+}
+
 func prefixResourceTypeAndName(message string, resource client.Object) string {
 	return fmt.Sprintf("%s %s/%s: %s",
 		resource.GetObjectKind().GroupVersionKind().Kind,
@@ -672,6 +994,7 @@ func handleError(request *common.Request, errParam error, logger logr.Logger) (c
 		Reason:  "Degraded",
 		Message: errorMsg,
 	})
+	appendReconcileHistory(&sspStatus.Conditions, "Error")
 	err := request.Client.Status().Update(request.Context, request.Instance)
 	if err != nil {
 		request.Logger.Error(err, "Error updating SSP status.")
@@ -732,6 +1055,8 @@ func watchClusterResources(builder *ctrl.Builder, crdList crd_watch.CrdList, ssp
 func watchResources(ctrlBuilder *ctrl.Builder, crdList crd_watch.CrdList, handler handler.EventHandler, sspOperands []operands.Operand, watchTypesFunc func(operands.Operand) []operands.WatchType, hookFunc handler_hook.HookFunc) {
 	// Deduplicate watches
 	watchedTypes := make(map[reflect.Type]operands.WatchType)
+	labelSelectors := make(map[reflect.Type][]labels.Selector)
+	unfilteredTypes := make(map[reflect.Type]bool)
 	for _, operand := range sspOperands {
 		for _, watchType := range watchTypesFunc(operand) {
 			key := reflect.TypeOf(watchType.Object)
@@ -741,10 +1066,18 @@ func watchResources(ctrlBuilder *ctrl.Builder, crdList crd_watch.CrdList, handle
 				watchType.WatchFullObject = watchType.WatchFullObject || wt.WatchFullObject
 			}
 			watchedTypes[key] = watchType
+
+			// If at least one watchType does not have a label selector,
+			// then all objects of the type should be watched.
+			if watchType.LabelSelector == nil {
+				unfilteredTypes[key] = true
+			} else {
+				labelSelectors[key] = append(labelSelectors[key], watchType.LabelSelector)
+			}
 		}
 	}
 
-	for _, watchType := range watchedTypes {
+	for key, watchType := range watchedTypes {
 		if watchType.Crd != "" && !crdList.CrdExists(watchType.Crd) {
 			// Do not watch resources without CRD
 			continue
@@ -752,7 +1085,10 @@ func watchResources(ctrlBuilder *ctrl.Builder, crdList crd_watch.CrdList, handle
 
 		var predicates []predicate.Predicate
 		if !watchType.WatchFullObject {
-			predicates = []predicate.Predicate{relevantChangesPredicate()}
+			predicates = append(predicates, relevantChangesPredicate())
+		}
+		if !unfilteredTypes[key] {
+			predicates = append(predicates, labelSelectorsPredicate(labelSelectors[key]))
 		}
 
 		ctrlBuilder.Watches(
@@ -763,6 +1099,15 @@ func watchResources(ctrlBuilder *ctrl.Builder, crdList crd_watch.CrdList, handle
 	}
 }
 
+// labelSelectorsPredicate is used to only reconcile on changes to objects matching any of the selectors
+func labelSelectorsPredicate(selectors []labels.Selector) predicate.Predicate {
+	selectorPredicates := make([]predicate.Predicate, 0, len(selectors))
+	for _, selector := range selectors {
+		selectorPredicates = append(selectorPredicates, predicates.LabelSelectorPredicate{Selector: selector})
+	}
+	return predicate.Or(selectorPredicates...)
+}
+
 // relevantChangesPredicate is used to only reconcile on certain changes to watched resources
 // - any change in spec
 // - labels or annotations - to detect if necessary labels or annotations were modified or removed
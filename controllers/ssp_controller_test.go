@@ -0,0 +1,690 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	osconfv1 "github.com/openshift/api/config/v1"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	v1 "k8s.io/api/core/v1"
+	rbac "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	lifecycleapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	ssp "kubevirt.io/ssp-operator/api/v1beta2"
+	"kubevirt.io/ssp-operator/internal/common"
+	crd_watch "kubevirt.io/ssp-operator/internal/crd-watch"
+	"kubevirt.io/ssp-operator/internal/operands"
+)
+
+var _ = Describe("SSP controller", func() {
+	Context("reconcile history", func() {
+		var conditions []conditionsv1.Condition
+
+		BeforeEach(func() {
+			conditions = nil
+		})
+
+		getHistory := func() string {
+			condition := conditionsv1.FindStatusCondition(conditions, conditionReconcileHistory)
+			Expect(condition).ToNot(BeNil())
+			return condition.Message
+		}
+
+		It("should add outcomes in order", func() {
+			appendReconcileHistory(&conditions, "OK")
+			Expect(getHistory()).To(Equal("OK"))
+
+			appendReconcileHistory(&conditions, "Degraded")
+			appendReconcileHistory(&conditions, "OK")
+			Expect(getHistory()).To(Equal("OK, Degraded, OK"))
+		})
+
+		It("should keep only the last outcomes", func() {
+			for i := 0; i < reconcileHistorySize; i++ {
+				appendReconcileHistory(&conditions, "OK")
+			}
+			appendReconcileHistory(&conditions, "Error")
+			appendReconcileHistory(&conditions, "Progressing")
+
+			history := strings.Split(getHistory(), reconcileHistorySeparator)
+			Expect(history).To(HaveLen(reconcileHistorySize))
+			Expect(history[reconcileHistorySize-2:]).To(Equal([]string{"Error", "Progressing"}))
+			Expect(history[:reconcileHistorySize-2]).To(HaveEach("OK"))
+		})
+	})
+
+	Context("operand ready condition", func() {
+		const operandName = "test-operand"
+
+		var conditions []conditionsv1.Condition
+
+		BeforeEach(func() {
+			conditions = nil
+		})
+
+		newResult := func(name string, status common.ResourceStatus) common.ReconcileResult {
+			return common.ReconcileResult{
+				Status: status,
+				Resource: &v1.ConfigMap{
+					TypeMeta:   metav1.TypeMeta{Kind: "ConfigMap"},
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-ns"},
+				},
+				OperationResult: common.OperationResultUpdated,
+			}
+		}
+
+		getCondition := func() *conditionsv1.Condition {
+			condition := conditionsv1.FindStatusCondition(conditions, "TestOperandReady")
+			Expect(condition).ToNot(BeNil())
+			return condition
+		}
+
+		It("should derive condition type from operand name", func() {
+			Expect(operandReadyConditionType("common-templates")).To(Equal(conditionsv1.ConditionType("CommonTemplatesReady")))
+			Expect(operandReadyConditionType("metrics")).To(Equal(conditionsv1.ConditionType("MetricsReady")))
+		})
+
+		It("should be true when all resources are ready", func() {
+			setOperandReadyCondition(&conditions, operandName, []common.ReconcileResult{
+				newResult("first", common.ResourceStatus{}),
+				newResult("second", common.ResourceStatus{}),
+			}, nil)
+
+			Expect(getCondition().Status).To(Equal(v1.ConditionTrue))
+		})
+
+		It("should be false with the last failing resource", func() {
+			setOperandReadyCondition(&conditions, operandName, []common.ReconcileResult{
+				newResult("first", common.ResourceStatus{Progressing: ptr.To("first progressing")}),
+				newResult("second", common.ResourceStatus{Degraded: ptr.To("second degraded")}),
+				newResult("third", common.ResourceStatus{}),
+			}, nil)
+
+			condition := getCondition()
+			Expect(condition.Status).To(Equal(v1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("NotReady"))
+			Expect(condition.Message).To(Equal("ConfigMap test-ns/second: second degraded"))
+		})
+
+		It("should flip to false on reconcile error", func() {
+			setOperandReadyCondition(&conditions, operandName, nil, nil)
+			Expect(getCondition().Status).To(Equal(v1.ConditionTrue))
+
+			setOperandReadyCondition(&conditions, operandName, nil, fmt.Errorf("no matches for kind \"Test\""))
+
+			condition := getCondition()
+			Expect(condition.Status).To(Equal(v1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("ReconcileFailed"))
+			Expect(condition.Message).To(ContainSubstring("no matches for kind"))
+		})
+	})
+
+	Context("missing CRDs", func() {
+		It("should set ready condition of every operand to false when a CRD disappears", func() {
+			Expect(ssp.AddToScheme(scheme.Scheme)).To(Succeed())
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithStatusSubresource(&ssp.SSP{}).
+				WithObjects(&ssp.SSP{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "test-ssp",
+						Namespace:  "test-ns",
+						Finalizers: []string{finalizerName},
+					},
+					Status: ssp.SSPStatus{
+						Status: lifecycleapi.Status{Phase: lifecycleapi.PhaseDeployed},
+					},
+				}).
+				Build()
+
+			crdList := &fakeCrdList{}
+			reconciler := NewSspReconciler(fakeClient, fakeClient, osconfv1.HighlyAvailableTopologyMode, []operands.Operand{
+				&fakeOperand{name: "first-operand"},
+				&fakeOperand{name: "second-operand"},
+			}, crdList, false, nil)
+
+			key := types.NamespacedName{Namespace: "test-ns", Name: "test-ssp"}
+			reconcileSsp := func() *ssp.SSP {
+				_, err := reconciler.Reconcile(context.Background(), reconcile.Request{NamespacedName: key})
+				Expect(err).ToNot(HaveOccurred())
+
+				instance := &ssp.SSP{}
+				Expect(fakeClient.Get(context.Background(), key, instance)).To(Succeed())
+				return instance
+			}
+
+			operandConditionTypes := []conditionsv1.ConditionType{"FirstOperandReady", "SecondOperandReady"}
+
+			instance := reconcileSsp()
+			for _, conditionType := range operandConditionTypes {
+				Expect(conditionsv1.IsStatusConditionTrue(instance.Status.Conditions, conditionType)).To(BeTrue(), string(conditionType))
+			}
+
+			crdList.missingCrds = []string{"tests.test.kubevirt.io"}
+			reconciler.areCrdsMissing = true
+
+			instance = reconcileSsp()
+			for _, conditionType := range operandConditionTypes {
+				condition := conditionsv1.FindStatusCondition(instance.Status.Conditions, conditionType)
+				Expect(condition).ToNot(BeNil(), string(conditionType))
+				Expect(condition.Status).To(Equal(v1.ConditionFalse))
+				Expect(condition.Reason).To(Equal("MissingCrds"))
+				Expect(condition.Message).To(ContainSubstring("tests.test.kubevirt.io"))
+			}
+		})
+	})
+
+	Context("paused by annotation", func() {
+		var (
+			reconciler *sspReconciler
+			reconciled int
+		)
+
+		BeforeEach(func() {
+			reconciled = 0
+
+			Expect(ssp.AddToScheme(scheme.Scheme)).To(Succeed())
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithStatusSubresource(&ssp.SSP{}).
+				WithObjects(&ssp.SSP{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "test-ssp",
+						Namespace:   "test-ns",
+						Finalizers:  []string{finalizerName},
+						Annotations: map[string]string{ssp.OperatorPausedAnnotation: "true"},
+					},
+					Status: ssp.SSPStatus{
+						Status: lifecycleapi.Status{Phase: lifecycleapi.PhaseDeployed},
+					},
+				}).
+				Build()
+
+			reconciler = NewSspReconciler(fakeClient, fakeClient, osconfv1.HighlyAvailableTopologyMode, []operands.Operand{
+				&fakeOperand{
+					name: "test-operand",
+					reconcile: func(*common.Request) ([]common.ReconcileResult, error) {
+						reconciled++
+						return nil, nil
+					},
+				},
+			}, nil, false, nil)
+		})
+
+		reconcileSsp := func() *ssp.SSP {
+			key := types.NamespacedName{Namespace: "test-ns", Name: "test-ssp"}
+			_, err := reconciler.Reconcile(context.Background(), reconcile.Request{NamespacedName: key})
+			Expect(err).ToNot(HaveOccurred())
+
+			instance := &ssp.SSP{}
+			Expect(reconciler.client.Get(context.Background(), key, instance)).To(Succeed())
+			return instance
+		}
+
+		It("should set Paused condition and not reconcile operands", func() {
+			instance := reconcileSsp()
+			Expect(reconciled).To(BeZero())
+			Expect(instance.Status.Paused).To(BeTrue())
+
+			condition := conditionsv1.FindStatusCondition(instance.Status.Conditions, conditionPaused)
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Status).To(Equal(v1.ConditionTrue))
+			Expect(condition.Message).To(ContainSubstring(ssp.OperatorPausedAnnotation))
+		})
+
+		It("should remove Paused condition and reconcile operands when resumed", func() {
+			instance := reconcileSsp()
+			delete(instance.Annotations, ssp.OperatorPausedAnnotation)
+			Expect(reconciler.client.Update(context.Background(), instance)).To(Succeed())
+
+			instance = reconcileSsp()
+			Expect(reconciled).To(Equal(1))
+			Expect(instance.Status.Paused).To(BeFalse())
+			Expect(conditionsv1.FindStatusCondition(instance.Status.Conditions, conditionPaused)).To(BeNil())
+		})
+	})
+
+	Context("read-only mode", func() {
+		newReconciler := func(readOnly bool, reconcileFunc func(*common.Request) ([]common.ReconcileResult, error)) *sspReconciler {
+			Expect(ssp.AddToScheme(scheme.Scheme)).To(Succeed())
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithStatusSubresource(&ssp.SSP{}).
+				WithObjects(&ssp.SSP{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "test-ssp",
+						Namespace:  "test-ns",
+						Finalizers: []string{finalizerName},
+					},
+					Status: ssp.SSPStatus{
+						Status: lifecycleapi.Status{
+							Phase: lifecycleapi.PhaseDeployed,
+							Conditions: []conditionsv1.Condition{{
+								Type:   conditionReadOnly,
+								Status: v1.ConditionTrue,
+							}},
+						},
+					},
+				}).
+				Build()
+
+			return NewSspReconciler(fakeClient, fakeClient, osconfv1.HighlyAvailableTopologyMode, []operands.Operand{
+				&fakeOperand{name: "test-operand", reconcile: reconcileFunc},
+			}, nil, readOnly, nil)
+		}
+
+		reconcileSsp := func(reconciler *sspReconciler) *ssp.SSP {
+			key := types.NamespacedName{Namespace: "test-ns", Name: "test-ssp"}
+			_, err := reconciler.Reconcile(context.Background(), reconcile.Request{NamespacedName: key})
+			Expect(err).ToNot(HaveOccurred())
+
+			instance := &ssp.SSP{}
+			Expect(reconciler.client.Get(context.Background(), key, instance)).To(Succeed())
+			return instance
+		}
+
+		It("should set ReadOnly condition and not create operand resources", func() {
+			configMap := &v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-config-map",
+					Namespace: "test-ns",
+				},
+			}
+
+			reconciler := newReconciler(true, func(request *common.Request) ([]common.ReconcileResult, error) {
+				Expect(request.ReadOnly).To(BeTrue())
+				return nil, request.WriteClient().Create(request.Context, configMap.DeepCopy())
+			})
+
+			instance := reconcileSsp(reconciler)
+
+			condition := conditionsv1.FindStatusCondition(instance.Status.Conditions, conditionReadOnly)
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Status).To(Equal(v1.ConditionTrue))
+
+			err := reconciler.client.Get(context.Background(), client.ObjectKeyFromObject(configMap), &v1.ConfigMap{})
+			Expect(errors.IsNotFound(err)).To(BeTrue(), "resource should not be created in read-only mode")
+		})
+
+		It("should not clean up deleted SSP and report it once in ReadOnly condition", func() {
+			reconciler := newReconciler(true, nil)
+			reconciler.operands = []operands.Operand{&fakeOperand{
+				name: "test-operand",
+				cleanup: func(*common.Request) ([]common.CleanupResult, error) {
+					Fail("cleanup should not be called in read-only mode")
+					return nil, nil
+				},
+			}}
+
+			key := types.NamespacedName{Namespace: "test-ns", Name: "test-ssp"}
+			instance := &ssp.SSP{}
+			Expect(reconciler.client.Get(context.Background(), key, instance)).To(Succeed())
+			Expect(reconciler.client.Delete(context.Background(), instance)).To(Succeed())
+
+			reconcileDeleted := func() *ssp.SSP {
+				result, err := reconciler.Reconcile(context.Background(), reconcile.Request{NamespacedName: key})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal(reconcile.Result{}))
+
+				instance := &ssp.SSP{}
+				Expect(reconciler.client.Get(context.Background(), key, instance)).To(Succeed())
+				return instance
+			}
+
+			instance = reconcileDeleted()
+			Expect(instance.Finalizers).To(ContainElement(finalizerName))
+			Expect(instance.Status.Phase).To(Equal(lifecycleapi.PhaseDeployed))
+
+			condition := conditionsv1.FindStatusCondition(instance.Status.Conditions, conditionReadOnly)
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Status).To(Equal(v1.ConditionTrue))
+			Expect(condition.Message).To(Equal(readOnlyDeletionMessage))
+
+			resourceVersion := instance.ResourceVersion
+			instance = reconcileDeleted()
+			Expect(instance.ResourceVersion).To(Equal(resourceVersion), "status should be updated only once")
+		})
+
+		It("should remove ReadOnly condition when not in read-only mode", func() {
+			instance := reconcileSsp(newReconciler(false, nil))
+			Expect(conditionsv1.FindStatusCondition(instance.Status.Conditions, conditionReadOnly)).To(BeNil())
+		})
+	})
+
+	Context("unknown bundle kinds", func() {
+		newReconciler := func(unknownBundleKinds []string) *sspReconciler {
+			Expect(ssp.AddToScheme(scheme.Scheme)).To(Succeed())
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithStatusSubresource(&ssp.SSP{}).
+				WithObjects(&ssp.SSP{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "test-ssp",
+						Namespace:  "test-ns",
+						Finalizers: []string{finalizerName},
+					},
+					Status: ssp.SSPStatus{
+						Status: lifecycleapi.Status{
+							Phase: lifecycleapi.PhaseDeployed,
+							Conditions: []conditionsv1.Condition{{
+								Type:   conditionUnknownBundleKinds,
+								Status: v1.ConditionTrue,
+							}},
+						},
+					},
+				}).
+				Build()
+
+			return NewSspReconciler(fakeClient, fakeClient, osconfv1.HighlyAvailableTopologyMode, []operands.Operand{
+				&fakeOperand{name: "test-operand"},
+			}, nil, false, unknownBundleKinds)
+		}
+
+		reconcileSsp := func(reconciler *sspReconciler) *ssp.SSP {
+			key := types.NamespacedName{Namespace: "test-ns", Name: "test-ssp"}
+			_, err := reconciler.Reconcile(context.Background(), reconcile.Request{NamespacedName: key})
+			Expect(err).ToNot(HaveOccurred())
+
+			instance := &ssp.SSP{}
+			Expect(reconciler.client.Get(context.Background(), key, instance)).To(Succeed())
+			return instance
+		}
+
+		It("should list unknown bundle kinds in condition", func() {
+			instance := reconcileSsp(newReconciler([]string{"ConfigMap", "Secret"}))
+
+			condition := conditionsv1.FindStatusCondition(instance.Status.Conditions, conditionUnknownBundleKinds)
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Status).To(Equal(v1.ConditionTrue))
+			Expect(condition.Message).To(ContainSubstring("ConfigMap, Secret"))
+		})
+
+		It("should remove condition if there are no unknown bundle kinds", func() {
+			instance := reconcileSsp(newReconciler(nil))
+			Expect(conditionsv1.FindStatusCondition(instance.Status.Conditions, conditionUnknownBundleKinds)).To(BeNil())
+		})
+	})
+
+	Context("cleanup", func() {
+		It("should requeue until all resources are deleted", func() {
+			const pendingCleanups = 3
+
+			Expect(ssp.AddToScheme(scheme.Scheme)).To(Succeed())
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithStatusSubresource(&ssp.SSP{}).
+				WithObjects(&ssp.SSP{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "test-ssp",
+						Namespace:         "test-ns",
+						Finalizers:        []string{finalizerName},
+						DeletionTimestamp: ptr.To(metav1.Now()),
+					},
+				}).
+				Build()
+
+			cleanupCalls := 0
+			reconciler := NewSspReconciler(fakeClient, fakeClient, osconfv1.HighlyAvailableTopologyMode, []operands.Operand{
+				&fakeOperand{
+					name: "test-operand",
+					cleanup: func(*common.Request) ([]common.CleanupResult, error) {
+						cleanupCalls++
+						return []common.CleanupResult{{
+							Resource: &v1.ConfigMap{},
+							Deleted:  cleanupCalls > pendingCleanups,
+						}}, nil
+					},
+				},
+			}, nil, false, nil)
+
+			key := types.NamespacedName{Namespace: "test-ns", Name: "test-ssp"}
+			resourceVersion := ""
+			// Only requeue requests returned by the reconciler drive the cleanup, no watch events are triggered.
+			for i := 0; i < pendingCleanups; i++ {
+				result, err := reconciler.Reconcile(context.Background(), reconcile.Request{NamespacedName: key})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.RequeueAfter).To(Equal(cleanupRequeueInterval))
+
+				instance := &ssp.SSP{}
+				Expect(fakeClient.Get(context.Background(), key, instance)).To(Succeed())
+				Expect(instance.Status.Phase).To(Equal(lifecycleapi.PhaseDeleting))
+				if resourceVersion != "" {
+					Expect(instance.ResourceVersion).To(Equal(resourceVersion), "status should not be updated on every requeue")
+				}
+				resourceVersion = instance.ResourceVersion
+			}
+
+			result, err := reconciler.Reconcile(context.Background(), reconcile.Request{NamespacedName: key})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+			Expect(cleanupCalls).To(Equal(pendingCleanups + 1))
+
+			err = fakeClient.Get(context.Background(), key, &ssp.SSP{})
+			Expect(errors.IsNotFound(err)).To(BeTrue(), "SSP should be deleted after finalizer is removed")
+		})
+	})
+
+	Context("operand priority", func() {
+		It("should sort operands by priority and keep registration order otherwise", func() {
+			sorted := sortOperandsByPriority([]operands.Operand{
+				&fakeOperand{name: "default-first"},
+				&fakePriorityOperand{fakeOperand: fakeOperand{name: "late"}, priority: 10},
+				&fakeOperand{name: "default-second"},
+				&fakePriorityOperand{fakeOperand: fakeOperand{name: "early"}, priority: -10},
+				&fakePriorityOperand{fakeOperand: fakeOperand{name: "explicit-default"}, priority: operands.DefaultPriority},
+			})
+
+			names := make([]string, 0, len(sorted))
+			for _, operand := range sorted {
+				names = append(names, operand.Name())
+			}
+			Expect(names).To(Equal([]string{"early", "default-first", "default-second", "explicit-default", "late"}))
+		})
+	})
+
+	Context("countResourcesByKind", func() {
+		It("should count resources by kind", func() {
+			counts := countResourcesByKind([]client.Object{
+				&v1.ConfigMap{},
+				&v1.ConfigMap{},
+				&rbac.ClusterRole{},
+				nil,
+			})
+			Expect(counts).To(Equal(map[string]int{
+				"ConfigMap":   2,
+				"ClusterRole": 1,
+			}))
+		})
+	})
+
+	Context("reconcileOperand", func() {
+		It("should reconcile operand with bounded context and restore the parent context", func() {
+			parentCtx := context.Background()
+			request := &common.Request{Context: parentCtx}
+
+			var operandCtx context.Context
+			operand := &fakeOperand{
+				name: "test-operand",
+				reconcile: func(request *common.Request) ([]common.ReconcileResult, error) {
+					operandCtx = request.Context
+					return nil, nil
+				},
+			}
+
+			_, err := reconcileOperand(request, operand)
+			Expect(err).ToNot(HaveOccurred())
+
+			deadline, hasDeadline := operandCtx.Deadline()
+			Expect(hasDeadline).To(BeTrue())
+			Expect(time.Until(deadline)).To(BeNumerically("<=", operandReconcileTimeout))
+			Expect(operandCtx.Err()).To(MatchError(context.Canceled), "operand context should be released")
+			Expect(request.Context).To(BeIdenticalTo(parentCtx))
+		})
+	})
+
+	Context("operands health check", func() {
+		var (
+			reconciler  *sspReconciler
+			healthErr   error
+			healthCalls int
+		)
+
+		BeforeEach(func() {
+			healthErr = nil
+			healthCalls = 0
+
+			Expect(ssp.AddToScheme(scheme.Scheme)).To(Succeed())
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+
+			reconciler = NewSspReconciler(fakeClient, fakeClient, osconfv1.HighlyAvailableTopologyMode, []operands.Operand{
+				&fakeOperand{name: "without-health"},
+				&fakeHealthOperand{
+					fakeOperand: fakeOperand{name: "with-health"},
+					health: func(request *common.Request) error {
+						healthCalls++
+						Expect(request.ReadOnly).To(BeTrue())
+						return healthErr
+					},
+				},
+			}, nil, false, nil)
+		})
+
+		createSsp := func() {
+			instance := &ssp.SSP{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-ssp",
+					Namespace: "test-ns",
+				},
+			}
+			Expect(reconciler.client.Create(context.Background(), instance)).To(Succeed())
+		}
+
+		probeRequest := func() *http.Request {
+			return httptest.NewRequest(http.MethodGet, "/readyz", nil)
+		}
+
+		It("should be healthy without SSP resource", func() {
+			Expect(reconciler.operandsHealthCheck(probeRequest())).To(Succeed())
+			Expect(healthCalls).To(BeZero())
+		})
+
+		It("should be healthy when operands are healthy", func() {
+			createSsp()
+			Expect(reconciler.operandsHealthCheck(probeRequest())).To(Succeed())
+			Expect(healthCalls).To(Equal(1))
+		})
+
+		It("should fail when an operand is not healthy", func() {
+			createSsp()
+			healthErr = fmt.Errorf("test error")
+
+			err := reconciler.operandsHealthCheck(probeRequest())
+			Expect(err).To(MatchError(ContainSubstring("operand with-health is not healthy")))
+			Expect(err).To(MatchError(healthErr))
+		})
+
+		It("should not list SSP resources when no operand implements health check", func() {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithInterceptorFuncs(interceptor.Funcs{
+					List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+						return fmt.Errorf("unexpected list call")
+					},
+				}).
+				Build()
+
+			reconciler = NewSspReconciler(fakeClient, fakeClient, osconfv1.HighlyAvailableTopologyMode, []operands.Operand{
+				&fakeOperand{name: "without-health"},
+			}, nil, false, nil)
+
+			Expect(reconciler.operandsHealthCheck(probeRequest())).To(Succeed())
+		})
+	})
+})
+
+type fakeOperand struct {
+	name      string
+	reconcile func(*common.Request) ([]common.ReconcileResult, error)
+	cleanup   func(*common.Request) ([]common.CleanupResult, error)
+}
+
+var _ operands.Operand = &fakeOperand{}
+
+func (f *fakeOperand) Name() string {
+	return f.name
+}
+
+func (f *fakeOperand) WatchTypes() []operands.WatchType {
+	return nil
+}
+
+func (f *fakeOperand) WatchClusterTypes() []operands.WatchType {
+	return nil
+}
+
+func (f *fakeOperand) Reconcile(request *common.Request) ([]common.ReconcileResult, error) {
+	if f.reconcile != nil {
+		return f.reconcile(request)
+	}
+	return nil, nil
+}
+
+func (f *fakeOperand) Cleanup(request *common.Request) ([]common.CleanupResult, error) {
+	if f.cleanup != nil {
+		return f.cleanup(request)
+	}
+	return nil, nil
+}
+
+type fakeCrdList struct {
+	missingCrds []string
+}
+
+var _ crd_watch.CrdList = &fakeCrdList{}
+
+func (f *fakeCrdList) CrdExists(crdName string) bool {
+	return !slices.Contains(f.missingCrds, crdName)
+}
+
+func (f *fakeCrdList) MissingCrds() []string {
+	return f.missingCrds
+}
+
+type fakeHealthOperand struct {
+	fakeOperand
+	health func(*common.Request) error
+}
+
+var _ operands.HealthChecker = &fakeHealthOperand{}
+
+func (f *fakeHealthOperand) Health(request *common.Request) error {
+	return f.health(request)
+}
+
+type fakePriorityOperand struct {
+	fakeOperand
+	priority int
+}
+
+var _ operands.Prioritized = &fakePriorityOperand{}
+
+func (f *fakePriorityOperand) Priority() int {
+	return f.priority
+}
@@ -32,7 +32,12 @@ const (
 //
 // The SSP operator already watches all ValidatingWebhookConfigurations, because
 // of template validator operand, so this controller is not a performance issue.
-func NewWebhookConfigurationController(apiClient client.Client) ControllerReconciler {
+//
+// In read-only mode, the updates are only sent as dry-run requests.
+func NewWebhookConfigurationController(apiClient client.Client, readOnly bool) ControllerReconciler {
+	if readOnly {
+		apiClient = client.NewDryRunClient(apiClient)
+	}
 	return &webhookCtrl{
 		apiClient: apiClient,
 	}
@@ -66,7 +66,7 @@ var _ = Describe("Webhook controller", func() {
 
 		fakeClient = fake.NewClientBuilder().WithScheme(common.Scheme).Build()
 
-		testController = NewWebhookConfigurationController(fakeClient)
+		testController = NewWebhookConfigurationController(fakeClient, false)
 
 		testRequest = reconcile.Request{
 			NamespacedName: types.NamespacedName{
@@ -117,4 +117,21 @@ var _ = Describe("Webhook controller", func() {
 
 		Expect(updatedConfig).To(Equal(webhookConfig))
 	})
+
+	It("should not update webhook in read-only mode", func() {
+		testController = NewWebhookConfigurationController(fakeClient, true)
+
+		Expect(fakeClient.Create(context.Background(), webhookConfig)).To(Succeed())
+
+		_, err := testController.Reconcile(context.Background(), testRequest)
+		Expect(err).ToNot(HaveOccurred())
+
+		updatedConfig := &admissionv1.ValidatingWebhookConfiguration{}
+		Expect(fakeClient.Get(context.Background(), client.ObjectKeyFromObject(webhookConfig), updatedConfig)).To(Succeed())
+
+		Expect(updatedConfig.Webhooks).ToNot(BeEmpty())
+		for _, webhook := range updatedConfig.Webhooks {
+			Expect(webhook.NamespaceSelector).ToNot(BeNil())
+		}
+	})
 })
@@ -1,6 +1,8 @@
 package common
 
 import (
+	"sync"
+
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -17,10 +19,23 @@ type cacheValue struct {
 	generation      int64
 }
 
-type VersionCache map[cacheKey]cacheValue
+// VersionCache can be used from concurrently running ReconcileFuncs.
+type VersionCache struct {
+	lock    sync.RWMutex
+	entries map[cacheKey]cacheValue
+}
 
-func (v VersionCache) Contains(obj client.Object) bool {
-	cached, ok := v[cacheKeyFromObj(obj)]
+func NewVersionCache() *VersionCache {
+	return &VersionCache{
+		entries: map[cacheKey]cacheValue{},
+	}
+}
+
+func (v *VersionCache) Contains(obj client.Object) bool {
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+
+	cached, ok := v.entries[cacheKeyFromObj(obj)]
 	if !ok {
 		return false
 	}
@@ -34,21 +49,26 @@ func (v VersionCache) Contains(obj client.Object) bool {
 	return cached.generation == obj.GetGeneration()
 }
 
-func (v VersionCache) Add(obj client.Object) {
+func (v *VersionCache) Add(obj client.Object) {
 	kind := obj.GetObjectKind().GroupVersionKind().Kind
 	if kind == "" {
 		// Do not cache objects without kind
 		return
 	}
-	v[cacheKeyFromObj(obj)] = cacheValue{
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.entries[cacheKeyFromObj(obj)] = cacheValue{
 		uid:             obj.GetUID(),
 		resourceVersion: obj.GetResourceVersion(),
 		generation:      obj.GetGeneration(),
 	}
 }
 
-func (v VersionCache) RemoveObj(obj client.Object) {
-	delete(v, cacheKeyFromObj(obj))
+func (v *VersionCache) RemoveObj(obj client.Object) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	delete(v.entries, cacheKeyFromObj(obj))
 }
 
 func cacheKeyFromObj(obj client.Object) cacheKey {
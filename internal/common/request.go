@@ -20,12 +20,27 @@ type Request struct {
 	Instance        *ssp.SSP
 	InstanceChanged bool
 	Logger          logr.Logger
-	VersionCache    VersionCache
+	VersionCache    *VersionCache
 	TopologyMode    osconfv1.TopologyMode
+	// ReadOnly is true if the operator runs in read-only mode.
+	// All writes to operand resources are sent as dry-run requests.
+	ReadOnly bool
+	// Transforms are applied in order to every resource
+	// reconciled by CreateOrUpdate, before the builder's own transforms.
+	Transforms []ResourceTransformFunc
 
 	CrdList crd_watch.CrdList
 }
 
+// WriteClient returns the client that should be used to modify operand resources.
+// In read-only mode, the returned client performs all writes as dry-run.
+func (r *Request) WriteClient() client.Client {
+	if r.ReadOnly {
+		return client.NewDryRunClient(r.Client)
+	}
+	return r.Client
+}
+
 func (r *Request) IsSingleReplicaTopologyMode() bool {
 	return r.TopologyMode == osconfv1.SingleReplicaTopologyMode
 }
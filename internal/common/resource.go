@@ -1,18 +1,28 @@
 package common
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"runtime"
+	"strings"
+	"sync"
 
 	"github.com/go-logr/logr"
 	routev1 "github.com/openshift/api/route/v1"
 	libhandler "github.com/operator-framework/operator-lib/handler"
 	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"gomodules.xyz/jsonpatch/v2"
 	apps "k8s.io/api/apps/v1"
 	core "k8s.io/api/core/v1"
 	rbac "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
 	instancetypev1alpha2 "kubevirt.io/api/instancetype/v1alpha2"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -41,6 +51,9 @@ type ReconcileResult struct {
 	InitialResource client.Object
 	Resource        client.Object
 	OperationResult OperationResult
+	// Diff is a JSON patch from InitialResource to the updated resource.
+	// It is only set if OperationResult is OperationResultUpdated.
+	Diff []jsonpatch.Operation
 }
 
 func (r *ReconcileResult) IsSuccess() bool {
@@ -59,6 +72,10 @@ type ReconcileFunc = func(*Request) (ReconcileResult, error)
 func CollectResourceStatus(request *Request, funcs ...ReconcileFunc) ([]ReconcileResult, error) {
 	res := make([]ReconcileResult, 0, len(funcs))
 	for _, f := range funcs {
+		// Stop early if the reconcile was cancelled or its deadline passed
+		if err := request.Context.Err(); err != nil {
+			return nil, err
+		}
 		status, err := f(request)
 		if err != nil {
 			return nil, err
@@ -68,15 +85,127 @@ func CollectResourceStatus(request *Request, funcs ...ReconcileFunc) ([]Reconcil
 	return res, nil
 }
 
+// CollectResourceStatusParallel works like CollectResourceStatus, but runs up to
+// workers funcs concurrently. If workers is not positive, runtime.GOMAXPROCS(0) is used.
+// The results are in the same order as funcs. If any func fails, the error of
+// the first failed func in that order is returned.
+func CollectResourceStatusParallel(request *Request, workers int, funcs ...ReconcileFunc) ([]ReconcileResult, error) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, len(funcs))
+
+	results := make([]ReconcileResult, len(funcs))
+	errs := make([]error, len(funcs))
+
+	indexes := make(chan int)
+	wg := sync.WaitGroup{}
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				if err := request.Context.Err(); err != nil {
+					errs[index] = err
+					continue
+				}
+				results[index], errs[index] = funcs[index](request)
+			}
+		}()
+	}
+
+	for i := range funcs {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// CollectResourceStatusWithRetry works like CollectResourceStatus, but retries
+// each func with exponential backoff while it returns a transient API error.
+// Other errors are returned immediately. Retrying stops when the backoff steps
+// are exhausted or when the request context is done.
+func CollectResourceStatusWithRetry(request *Request, backoff wait.Backoff, funcs ...ReconcileFunc) ([]ReconcileResult, error) {
+	retryFuncs := make([]ReconcileFunc, 0, len(funcs))
+	for _, f := range funcs {
+		retryFuncs = append(retryFuncs, RetryOnTransientError(backoff, f))
+	}
+	return CollectResourceStatus(request, retryFuncs...)
+}
+
+// RetryOnTransientError returns a ReconcileFunc that retries f with exponential
+// backoff while it returns an error for which IsTransientError is true.
+// If retries are exhausted, the last error returned by f is returned.
+// If the request context is done, its error is returned.
+func RetryOnTransientError(backoff wait.Backoff, f ReconcileFunc) ReconcileFunc {
+	return func(request *Request) (ReconcileResult, error) {
+		var result ReconcileResult
+		var lastErr error
+		err := wait.ExponentialBackoffWithContext(request.Context, backoff, func(_ context.Context) (bool, error) {
+			result, lastErr = f(request)
+			if lastErr == nil {
+				return true, nil
+			}
+			if IsTransientError(lastErr) {
+				request.Logger.V(1).Info(fmt.Sprintf("Retrying after transient error: %s", lastErr))
+				return false, nil
+			}
+			return false, lastErr
+		})
+		if err != nil {
+			if ctxErr := request.Context.Err(); ctxErr != nil {
+				return ReconcileResult{}, ctxErr
+			}
+			if wait.Interrupted(err) && lastErr != nil {
+				return ReconcileResult{}, lastErr
+			}
+			return ReconcileResult{}, err
+		}
+		return result, nil
+	}
+}
+
+// IsTransientError returns true for API errors that are likely to
+// succeed when the request is repeated.
+//
+// Conflicts are not included, because CreateOrUpdate already retries them
+// using ReconcileOptions.ConflictRetryBackoff.
+func IsTransientError(err error) bool {
+	return errors.IsTooManyRequests(err) ||
+		errors.IsServerTimeout(err) ||
+		errors.IsTimeout(err)
+}
+
 type ResourceUpdateFunc = func(expected, found client.Object)
 type ResourceStatusFunc = func(resource client.Object) ResourceStatus
 type ResourceSpecGetter = func(resource client.Object) interface{}
 
+// ResourceTransformFunc modifies the expected resource before it is created or updated.
+type ResourceTransformFunc = func(resource client.Object) error
+
 type ReconcileOptions struct {
 	// AlwaysCallUpdateFunc specifies if the UpdateFunc should be called
 	// on changes that don't increase the .metadata.generation field.
 	// For example, labels and annotations.
 	AlwaysCallUpdateFunc bool
+
+	// ConflictRetryBackoff specifies how the create or update is retried
+	// when it fails because of a conflict. The Jitter field spreads retries
+	// of concurrent reconciles in time, so they do not keep conflicting.
+	// If nil, retry.DefaultRetry is used.
+	ConflictRetryBackoff *wait.Backoff
+
+	// StripAnnotationsOnAdoption lists annotations that are removed
+	// from an existing resource that is not yet owned by the SSP CR,
+	// for example annotations set by its previous manager.
+	StripAnnotationsOnAdoption []string
 }
 
 type ReconcileBuilder interface {
@@ -87,8 +216,23 @@ type ReconcileBuilder interface {
 	StatusFunc(ResourceStatusFunc) ReconcileBuilder
 	ImmutableSpec(getter ResourceSpecGetter) ReconcileBuilder
 
+	// Immutable specifies fields of the resource that are owned by the operator.
+	// Field paths are dot separated, for example "spec.selector". On update, only
+	// these fields are copied from the expected resource, and the rest of the found
+	// resource is left as it is. If UpdateFunc is also set, it is called first.
+	Immutable(fieldPaths ...string) ReconcileBuilder
+
+	// Transform adds functions that modify the expected resource. They are applied
+	// in the order they were added, after the app labels are set.
+	// If any of them returns an error, the resource is not created or updated.
+	Transform(transforms ...ResourceTransformFunc) ReconcileBuilder
+
 	Options(options ReconcileOptions) ReconcileBuilder
 
+	// DryRun specifies that the create or update is only sent as a dry-run request.
+	// The returned result reflects what would happen, without changing the cluster.
+	DryRun() ReconcileBuilder
+
 	Reconcile() (ReconcileResult, error)
 }
 
@@ -101,13 +245,20 @@ type reconcileBuilder struct {
 	operandName      string
 	operandComponent AppComponent
 
-	updateFunc ResourceUpdateFunc
-	statusFunc ResourceStatusFunc
+	updateFunc       ResourceUpdateFunc
+	customUpdateFunc bool
+	statusFunc       ResourceStatusFunc
+
+	ownedFieldPaths [][]string
 
 	immutableSpec bool
 	specGetter    ResourceSpecGetter
 
+	transforms []ResourceTransformFunc
+
 	options ReconcileOptions
+
+	dryRun bool
 }
 
 var _ ReconcileBuilder = &reconcileBuilder{}
@@ -126,6 +277,7 @@ func (r *reconcileBuilder) ClusterResource(resource client.Object) ReconcileBuil
 
 func (r *reconcileBuilder) UpdateFunc(updateFunc ResourceUpdateFunc) ReconcileBuilder {
 	r.updateFunc = updateFunc
+	r.customUpdateFunc = true
 	return r
 }
 
@@ -147,45 +299,85 @@ func (r *reconcileBuilder) ImmutableSpec(specGetter ResourceSpecGetter) Reconcil
 	return r
 }
 
+func (r *reconcileBuilder) Immutable(fieldPaths ...string) ReconcileBuilder {
+	for _, fieldPath := range fieldPaths {
+		r.ownedFieldPaths = append(r.ownedFieldPaths, strings.Split(fieldPath, "."))
+	}
+	return r
+}
+
+func (r *reconcileBuilder) Transform(transforms ...ResourceTransformFunc) ReconcileBuilder {
+	r.transforms = append(r.transforms, transforms...)
+	return r
+}
+
 func (r *reconcileBuilder) Options(options ReconcileOptions) ReconcileBuilder {
 	r.options = options
 	return r
 }
 
+func (r *reconcileBuilder) DryRun() ReconcileBuilder {
+	r.dryRun = true
+	return r
+}
+
 func (r *reconcileBuilder) Reconcile() (ReconcileResult, error) {
 	if r.addLabels {
 		AddAppLabels(r.request.Instance, r.operandName, r.operandComponent, r.resource)
 	}
 
+	if err := r.applyTransforms(); err != nil {
+		return ReconcileResult{}, err
+	}
+
 	err := setOwner(r.request, r.resource, r.isClusterResource)
 	if err != nil {
 		return ReconcileResult{}, err
 	}
 
-	found := newEmptyResource(r.resource)
-	found.SetName(r.resource.GetName())
-	found.SetNamespace(r.resource.GetNamespace())
+	var found client.Object
 	mutateFn := func() error {
 		if !found.GetDeletionTimestamp().IsZero() {
 			// Skip update, because the resource is being deleted
 			return nil
 		}
 
+		if err := r.stripAnnotationsOnAdoption(found); err != nil {
+			return err
+		}
+
 		// We expect users will not add any other owner references,
 		// if that is not correct, this code needs to be changed.
 		found.SetOwnerReferences(r.resource.GetOwnerReferences())
 
 		UpdateLabels(r.resource, found)
 		updateAnnotations(r.resource, found)
-		if r.options.AlwaysCallUpdateFunc || !r.request.VersionCache.Contains(found) {
+		if r.options.AlwaysCallUpdateFunc || r.isDryRun() || !r.request.VersionCache.Contains(found) {
 			// The generation was updated by other cluster components,
 			// operator needs to update the resource
-			r.updateFunc(r.resource, found)
+			return r.update(found)
 		}
 		return nil
 	}
 
-	res, existing, err := r.createOrUpdateWithImmutableSpec(found, mutateFn)
+	var (
+		res      OperationResult
+		existing client.Object
+	)
+	err = retry.RetryOnConflict(r.conflictRetryBackoff(), func() error {
+		if err := r.request.Context.Err(); err != nil {
+			return err
+		}
+		// The object is fetched again on each attempt, so the mutate function
+		// is applied to the latest version of the resource.
+		found = newEmptyResource(r.resource)
+		found.SetName(r.resource.GetName())
+		found.SetNamespace(r.resource.GetNamespace())
+
+		var err error
+		res, existing, err = r.createOrUpdateWithImmutableSpec(found, mutateFn)
+		return err
+	})
 	if err != nil {
 		r.request.Logger.Info(fmt.Sprintf("Resource create/update failed: %v", err))
 		return ReconcileResult{}, err
@@ -195,11 +387,129 @@ func (r *reconcileBuilder) Reconcile() (ReconcileResult, error) {
 		return ResourceDeletedResult(r.resource, res), nil
 	}
 
-	r.request.VersionCache.Add(found)
+	if !r.isDryRun() {
+		// In dry-run nothing was written, so the version
+		// of the resource must not be cached.
+		r.request.VersionCache.Add(found)
+	}
 	logOperation(res, found, r.request.Logger)
 
+	var diff []jsonpatch.Operation
+	if res == OperationResultUpdated {
+		diff, err = resourceDiff(existing, found)
+		if err != nil {
+			// The diff is only informative, so the reconcile does not fail
+			r.request.Logger.V(1).Info(fmt.Sprintf("Failed to compute diff of %s: %v", found.GetName(), err))
+		}
+	}
+
 	status := r.statusFunc(found)
-	return ReconcileResult{status, existing, r.resource, res}, nil
+	return ReconcileResult{
+		Status:          status,
+		InitialResource: existing,
+		Resource:        r.resource,
+		OperationResult: res,
+		Diff:            diff,
+	}, nil
+}
+
+func (r *reconcileBuilder) update(found client.Object) error {
+	if len(r.ownedFieldPaths) == 0 {
+		r.updateFunc(r.resource, found)
+		return nil
+	}
+
+	// The default update func would overwrite fields not owned by the operator
+	if r.customUpdateFunc {
+		r.updateFunc(r.resource, found)
+	}
+	return copyFields(r.resource, found, r.ownedFieldPaths)
+}
+
+// copyFields copies the fields at fieldPaths from src to dst.
+// Fields missing in src are removed from dst.
+func copyFields(src, dst client.Object, fieldPaths [][]string) error {
+	srcContent, err := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(src)
+	if err != nil {
+		return err
+	}
+	dstContent, err := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(dst)
+	if err != nil {
+		return err
+	}
+
+	for _, fieldPath := range fieldPaths {
+		value, found, err := unstructured.NestedFieldCopy(srcContent, fieldPath...)
+		if err != nil {
+			return fmt.Errorf("failed to read field %s: %w", strings.Join(fieldPath, "."), err)
+		}
+		if !found {
+			unstructured.RemoveNestedField(dstContent, fieldPath...)
+			continue
+		}
+		if err := unstructured.SetNestedField(dstContent, value, fieldPath...); err != nil {
+			return fmt.Errorf("failed to set field %s: %w", strings.Join(fieldPath, "."), err)
+		}
+	}
+
+	updated := newEmptyResource(dst)
+	if err := k8sruntime.DefaultUnstructuredConverter.FromUnstructured(dstContent, updated); err != nil {
+		return err
+	}
+	reflect.ValueOf(dst).Elem().Set(reflect.ValueOf(updated).Elem())
+	return nil
+}
+
+func (r *reconcileBuilder) applyTransforms() error {
+	for _, transforms := range [][]ResourceTransformFunc{r.request.Transforms, r.transforms} {
+		for _, transform := range transforms {
+			if err := transform(r.resource); err != nil {
+				return fmt.Errorf("failed to transform resource %s: %w", r.resource.GetName(), err)
+			}
+		}
+	}
+	return nil
+}
+
+func (r *reconcileBuilder) isDryRun() bool {
+	return r.dryRun || r.request.ReadOnly
+}
+
+func (r *reconcileBuilder) writeClient() client.Client {
+	if r.dryRun {
+		return client.NewDryRunClient(r.request.Client)
+	}
+	return r.request.WriteClient()
+}
+
+func (r *reconcileBuilder) stripAnnotationsOnAdoption(found client.Object) error {
+	if len(r.options.StripAnnotationsOnAdoption) == 0 || len(found.GetAnnotations()) == 0 {
+		return nil
+	}
+
+	// isResourceOwned modifies the expected object, so a copy is passed
+	// to keep the owner annotations off namespaced resources
+	isOwned, err := isResourceOwned(r.request, r.resource.DeepCopyObject().(client.Object), found)
+	if err != nil {
+		return err
+	}
+	if isOwned {
+		return nil
+	}
+
+	annotations := found.GetAnnotations()
+	for _, annotation := range r.options.StripAnnotationsOnAdoption {
+		delete(annotations, annotation)
+	}
+	found.SetAnnotations(annotations)
+	return nil
+}
+
+func (r *reconcileBuilder) conflictRetryBackoff() wait.Backoff {
+	if r.options.ConflictRetryBackoff != nil {
+		return *r.options.ConflictRetryBackoff
+	}
+	return retry.DefaultRetry
 }
 
 func CreateOrUpdate(request *Request) ReconcileBuilder {
@@ -219,6 +529,15 @@ func CreateOrUpdate(request *Request) ReconcileBuilder {
 }
 
 func Cleanup(request *Request, resource client.Object) (CleanupResult, error) {
+	return cleanup(request, request.WriteClient(), resource)
+}
+
+// CleanupDryRun works like Cleanup, but the delete is only sent as a dry-run request.
+func CleanupDryRun(request *Request, resource client.Object) (CleanupResult, error) {
+	return cleanup(request, client.NewDryRunClient(request.Client), resource)
+}
+
+func cleanup(request *Request, writeClient client.Client, resource client.Object) (CleanupResult, error) {
 	found := newEmptyResource(resource)
 	err := request.Client.Get(request.Context, client.ObjectKeyFromObject(resource), found)
 	if errors.IsNotFound(err) {
@@ -246,7 +565,7 @@ func Cleanup(request *Request, resource client.Object) (CleanupResult, error) {
 	}
 
 	if found.GetDeletionTimestamp().IsZero() {
-		err = request.Client.Delete(request.Context, found)
+		err = writeClient.Delete(request.Context, found)
 		if errors.IsNotFound(err) {
 			return CleanupResult{
 				Resource: resource,
@@ -266,9 +585,44 @@ func Cleanup(request *Request, resource client.Object) (CleanupResult, error) {
 }
 
 func DeleteAll(request *Request, resources ...client.Object) ([]CleanupResult, error) {
+	return deleteAll(request, Cleanup, resources...)
+}
+
+// DeleteAllDryRun works like DeleteAll, but the deletes are only sent as dry-run requests.
+func DeleteAllDryRun(request *Request, resources ...client.Object) ([]CleanupResult, error) {
+	return deleteAll(request, CleanupDryRun, resources...)
+}
+
+// DeleteInOrder deletes groups of resources one after another. Resources of a group
+// are deleted only after all resources of the previous groups are deleted.
+// It returns the results of all groups that were started, so the caller can
+// retry the cleanup until all results report that the resource was deleted.
+func DeleteInOrder(request *Request, groups ...[]client.Object) ([]CleanupResult, error) {
+	var results []CleanupResult
+	for _, group := range groups {
+		groupResults, err := DeleteAll(request, group...)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, groupResults...)
+
+		for _, result := range groupResults {
+			if !result.Deleted {
+				// Wait until the group is deleted before deleting the next one
+				return results, nil
+			}
+		}
+	}
+	return results, nil
+}
+
+func deleteAll(request *Request, cleanupFunc func(*Request, client.Object) (CleanupResult, error), resources ...client.Object) ([]CleanupResult, error) {
 	var results []CleanupResult
 	for _, obj := range resources {
-		result, err := Cleanup(request, obj)
+		if err := request.Context.Err(); err != nil {
+			return nil, err
+		}
+		result, err := cleanupFunc(request, obj)
 		if err != nil {
 			return nil, err
 		}
@@ -287,7 +641,7 @@ func (r *reconcileBuilder) createOrUpdateWithImmutableSpec(obj client.Object, f
 		if err := mutate(f, key, obj); err != nil {
 			return OperationResultNone, nil, err
 		}
-		if err := r.request.Client.Create(r.request.Context, obj); err != nil {
+		if err := r.writeClient().Create(r.request.Context, obj); err != nil {
 			return OperationResultNone, nil, err
 		}
 		return OperationResultCreated, nil, nil
@@ -305,18 +659,56 @@ func (r *reconcileBuilder) createOrUpdateWithImmutableSpec(obj client.Object, f
 	// If the resource is immutable and specs are not equal, delete it.
 	// It will be recreated in the next iteration.
 	if r.immutableSpec && !equality.Semantic.DeepEqual(r.specGetter(existing), r.specGetter(obj)) {
-		if err := r.request.Client.Delete(r.request.Context, obj); err != nil {
+		if err := r.writeClient().Delete(r.request.Context, obj); err != nil {
 			return OperationResultNone, existing, err
 		}
 		return OperationResultDeleted, existing, nil
 	}
 
-	if err := r.request.Client.Update(r.request.Context, obj); err != nil {
+	if err := r.writeClient().Update(r.request.Context, obj); err != nil {
 		return OperationResultNone, existing, err
 	}
 	return OperationResultUpdated, existing, nil
 }
 
+// Metadata fields that are changed by the API server on every update
+var ignoredDiffPaths = []string{
+	"/metadata/resourceVersion",
+	"/metadata/generation",
+	"/metadata/managedFields",
+}
+
+func resourceDiff(original, updated client.Object) ([]jsonpatch.Operation, error) {
+	originalJson, err := json.Marshal(original)
+	if err != nil {
+		return nil, err
+	}
+	updatedJson, err := json.Marshal(updated)
+	if err != nil {
+		return nil, err
+	}
+
+	patch, err := jsonpatch.CreatePatch(originalJson, updatedJson)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := make([]jsonpatch.Operation, 0, len(patch))
+	for _, operation := range patch {
+		ignored := false
+		for _, path := range ignoredDiffPaths {
+			if operation.Path == path || strings.HasPrefix(operation.Path, path+"/") {
+				ignored = true
+				break
+			}
+		}
+		if !ignored {
+			diff = append(diff, operation)
+		}
+	}
+	return diff, nil
+}
+
 // This function is a copy of controllerutil.mutate
 func mutate(f controllerutil.MutateFn, key client.ObjectKey, obj client.Object) error {
 	if err := f(); err != nil {
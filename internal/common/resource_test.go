@@ -2,19 +2,28 @@ package common
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	libhandler "github.com/operator-framework/operator-lib/handler"
+	"gomodules.xyz/jsonpatch/v2"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -58,7 +67,7 @@ var _ = Describe("Resource", func() {
 				},
 			},
 			Logger:       log,
-			VersionCache: VersionCache{},
+			VersionCache: NewVersionCache(),
 		}
 	})
 
@@ -81,6 +90,49 @@ var _ = Describe("Resource", func() {
 			expectEqualResourceExists(newTestResource(namespace), &request)
 		})
 
+		It("should set diff of updated resource", func() {
+			resource := newTestResource(namespace)
+			resource.Spec.Ports[0].Name = "changed-name"
+			Expect(request.Client.Create(request.Context, resource)).ToNot(HaveOccurred())
+
+			result, err := createOrUpdateTestResource(&request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.OperationResult).To(Equal(OperationResultUpdated))
+			Expect(result.Diff).To(ContainElement(jsonpatch.NewOperation("replace", "/spec/ports/0/name", "webhook")))
+			Expect(result.Diff).ToNot(ContainElement(HaveField("Path", "/metadata/resourceVersion")))
+		})
+
+		It("should not set diff of unchanged resource", func() {
+			_, err := createOrUpdateTestResource(&request)
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := createOrUpdateTestResource(&request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.OperationResult).To(Equal(OperationResultNone))
+			Expect(result.Diff).To(BeEmpty())
+		})
+
+		It("should keep foreign labels when adding app labels", func() {
+			resource := newTestResource(namespace)
+			resource.Labels["foreign-label"] = "foreign-value"
+			resource.Labels[AppKubernetesNameLabel] = "foreign-name"
+			Expect(request.Client.Create(request.Context, resource)).To(Succeed())
+
+			_, err := CreateOrUpdate(&request).
+				NamespacedResource(newTestResource(namespace)).
+				WithAppLabels("test-operand", AppComponentTemplating).
+				Reconcile()
+			Expect(err).ToNot(HaveOccurred())
+
+			found := &v1.Service{}
+			Expect(request.Client.Get(request.Context, client.ObjectKeyFromObject(resource), found)).To(Succeed())
+			Expect(found.Labels).To(HaveKeyWithValue("foreign-label", "foreign-value"))
+			Expect(found.Labels).To(HaveKeyWithValue("test-label", "value1"))
+			Expect(found.Labels).To(HaveKeyWithValue(AppKubernetesNameLabel, "test-operand"))
+			Expect(found.Labels).To(HaveKeyWithValue(AppKubernetesComponentLabel, AppComponentTemplating.String()))
+			Expect(found.Labels).To(HaveKeyWithValue(AppKubernetesManagedByLabel, AppKubernetesManagedByValue))
+		})
+
 		It("should set owner reference", func() {
 			_, err := createOrUpdateTestResource(&request)
 			Expect(err).ToNot(HaveOccurred())
@@ -219,6 +271,616 @@ var _ = Describe("Resource", func() {
 		})
 	})
 
+	Context("CreateOrUpdate with immutable fields", func() {
+		BeforeEach(func() {
+			resource := newTestResource(namespace)
+			resource.Spec.Ports[0].Name = "changed-name"
+			resource.Spec.Selector["user-selector"] = "value"
+			Expect(request.Client.Create(request.Context, resource)).To(Succeed())
+		})
+
+		getService := func() *v1.Service {
+			found := &v1.Service{}
+			Expect(request.Client.Get(request.Context, client.ObjectKeyFromObject(newTestResource(namespace)), found)).To(Succeed())
+			return found
+		}
+
+		It("should update only immutable fields", func() {
+			_, err := CreateOrUpdate(&request).
+				NamespacedResource(newTestResource(namespace)).
+				Immutable("spec.ports").
+				Reconcile()
+			Expect(err).ToNot(HaveOccurred())
+
+			found := getService()
+			Expect(found.Spec.Ports).To(Equal(newTestResource(namespace).Spec.Ports))
+			Expect(found.Spec.Selector).To(HaveKeyWithValue("user-selector", "value"))
+		})
+
+		It("should remove immutable field missing in expected resource", func() {
+			expected := newTestResource(namespace)
+			expected.Spec.Selector = nil
+
+			_, err := CreateOrUpdate(&request).
+				NamespacedResource(expected).
+				Immutable("spec.selector").
+				Reconcile()
+			Expect(err).ToNot(HaveOccurred())
+
+			found := getService()
+			Expect(found.Spec.Selector).To(BeEmpty())
+			Expect(found.Spec.Ports[0].Name).To(Equal("changed-name"))
+		})
+
+		It("should call UpdateFunc before copying immutable fields", func() {
+			_, err := CreateOrUpdate(&request).
+				NamespacedResource(newTestResource(namespace)).
+				UpdateFunc(func(_, found client.Object) {
+					found.(*v1.Service).Spec.Selector["operator-selector"] = "value"
+					found.(*v1.Service).Spec.Ports[0].Port = 1234
+				}).
+				Immutable("spec.ports").
+				Reconcile()
+			Expect(err).ToNot(HaveOccurred())
+
+			found := getService()
+			Expect(found.Spec.Selector).To(HaveKeyWithValue("operator-selector", "value"))
+			Expect(found.Spec.Selector).To(HaveKeyWithValue("user-selector", "value"))
+			Expect(found.Spec.Ports).To(Equal(newTestResource(namespace).Spec.Ports))
+		})
+	})
+
+	Context("CreateOrUpdate with transforms", func() {
+		addLabel := func(key, value string) ResourceTransformFunc {
+			return func(resource client.Object) error {
+				labels := resource.GetLabels()
+				if labels == nil {
+					labels = map[string]string{}
+				}
+				labels[key] = value
+				resource.SetLabels(labels)
+				return nil
+			}
+		}
+
+		It("should apply transforms to created resource", func() {
+			_, err := CreateOrUpdate(&request).
+				NamespacedResource(newTestResource(namespace)).
+				Transform(func(resource client.Object) error {
+					resource.(*v1.Service).Spec.Ports[0].Port = 8443
+					return nil
+				}).
+				Reconcile()
+			Expect(err).ToNot(HaveOccurred())
+
+			found := &v1.Service{}
+			Expect(request.Client.Get(request.Context, client.ObjectKeyFromObject(newTestResource(namespace)), found)).To(Succeed())
+			Expect(found.Spec.Ports[0].Port).To(Equal(int32(8443)))
+		})
+
+		It("should apply transforms in order, request transforms first", func() {
+			request.Transforms = []ResourceTransformFunc{
+				addLabel("order", "request"),
+				addLabel("request-label", "value"),
+			}
+
+			_, err := CreateOrUpdate(&request).
+				NamespacedResource(newTestResource(namespace)).
+				Transform(addLabel("order", "first")).
+				Transform(addLabel("order", "second")).
+				Reconcile()
+			Expect(err).ToNot(HaveOccurred())
+
+			found := &v1.Service{}
+			Expect(request.Client.Get(request.Context, client.ObjectKeyFromObject(newTestResource(namespace)), found)).To(Succeed())
+			Expect(found.Labels).To(HaveKeyWithValue("order", "second"))
+			Expect(found.Labels).To(HaveKeyWithValue("request-label", "value"))
+		})
+
+		It("should not create resource when transform fails", func() {
+			_, err := CreateOrUpdate(&request).
+				NamespacedResource(newTestResource(namespace)).
+				Transform(func(client.Object) error {
+					return fmt.Errorf("test error")
+				}).
+				Reconcile()
+			Expect(err).To(MatchError(ContainSubstring("test error")))
+
+			err = request.Client.Get(request.Context, client.ObjectKeyFromObject(newTestResource(namespace)), &v1.Service{})
+			Expect(err).To(MatchError(errors.IsNotFound, "errors.IsNotFound"))
+		})
+	})
+
+	Context("CreateOrUpdate adoption", func() {
+		const (
+			strippedAnnotation = "previous-manager.io/owner"
+			keptAnnotation     = "foreign-annotation"
+		)
+
+		reconcileWithStripList := func() {
+			_, err := CreateOrUpdate(&request).
+				NamespacedResource(newTestResource(namespace)).
+				Options(ReconcileOptions{StripAnnotationsOnAdoption: []string{strippedAnnotation}}).
+				UpdateFunc(func(expected, found client.Object) {
+					found.(*v1.Service).Spec = expected.(*v1.Service).Spec
+				}).
+				Reconcile()
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		It("should remove listed annotations when adopting resource", func() {
+			resource := newTestResource(namespace)
+			resource.Annotations[strippedAnnotation] = "previous-manager"
+			resource.Annotations[keptAnnotation] = "value"
+			Expect(request.Client.Create(request.Context, resource)).To(Succeed())
+
+			reconcileWithStripList()
+
+			found := &v1.Service{}
+			Expect(request.Client.Get(request.Context, client.ObjectKeyFromObject(resource), found)).To(Succeed())
+			Expect(found.Annotations).ToNot(HaveKey(strippedAnnotation))
+			Expect(found.Annotations).To(HaveKeyWithValue(keptAnnotation, "value"))
+			Expect(found.GetOwnerReferences()).To(HaveLen(1))
+		})
+
+		It("should not add owner annotations to adopted namespaced resource", func() {
+			resource := newTestResource(namespace)
+			resource.Annotations[strippedAnnotation] = "previous-manager"
+			Expect(request.Client.Create(request.Context, resource)).To(Succeed())
+
+			reconcileWithStripList()
+
+			found := &v1.Service{}
+			Expect(request.Client.Get(request.Context, client.ObjectKeyFromObject(resource), found)).To(Succeed())
+			Expect(found.Annotations).ToNot(HaveKey(libhandler.NamespacedNameAnnotation))
+			Expect(found.Annotations).ToNot(HaveKey(libhandler.TypeAnnotation))
+		})
+
+		It("should not remove listed annotations from owned resource", func() {
+			reconcileWithStripList()
+
+			found := &v1.Service{}
+			key := client.ObjectKeyFromObject(newTestResource(namespace))
+			Expect(request.Client.Get(request.Context, key, found)).To(Succeed())
+			found.Annotations[strippedAnnotation] = "added-later"
+			Expect(request.Client.Update(request.Context, found)).To(Succeed())
+
+			reconcileWithStripList()
+
+			Expect(request.Client.Get(request.Context, key, found)).To(Succeed())
+			Expect(found.Annotations).To(HaveKeyWithValue(strippedAnnotation, "added-later"))
+		})
+	})
+
+	Context("CreateOrUpdate on conflict", func() {
+		const conflicts = 3
+
+		var updateTimes []time.Time
+
+		BeforeEach(func() {
+			resource := newTestResource(namespace)
+			resource.Spec.Ports[0].Name = "changed-name"
+			Expect(request.Client.Create(request.Context, resource)).To(Succeed())
+
+			updateTimes = nil
+			request.Client = interceptor.NewClient(request.Client.(client.WithWatch), interceptor.Funcs{
+				Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+					updateTimes = append(updateTimes, time.Now())
+					if len(updateTimes) <= conflicts {
+						return errors.NewConflict(schema.GroupResource{Resource: "services"}, obj.GetName(), fmt.Errorf("test conflict"))
+					}
+					return c.Update(ctx, obj, opts...)
+				},
+			})
+		})
+
+		It("should retry update with jittered backoff", func() {
+			backoff := wait.Backoff{
+				Steps:    conflicts + 1,
+				Duration: 20 * time.Millisecond,
+				Factor:   1.0,
+				Jitter:   1.0,
+			}
+
+			_, err := CreateOrUpdate(&request).
+				NamespacedResource(newTestResource(namespace)).
+				Options(ReconcileOptions{ConflictRetryBackoff: &backoff}).
+				UpdateFunc(func(expected, found client.Object) {
+					found.(*v1.Service).Spec = expected.(*v1.Service).Spec
+				}).
+				Reconcile()
+			Expect(err).ToNot(HaveOccurred())
+			expectEqualResourceExists(newTestResource(namespace), &request)
+
+			Expect(updateTimes).To(HaveLen(conflicts + 1))
+
+			// Upper bounds are not checked, because the test process can be
+			// descheduled for an arbitrary time on a loaded machine.
+			delays := make([]time.Duration, 0, len(updateTimes)-1)
+			for i := 1; i < len(updateTimes); i++ {
+				delay := updateTimes[i].Sub(updateTimes[i-1])
+				Expect(delay).To(BeNumerically(">=", backoff.Duration))
+				delays = append(delays, delay)
+			}
+			// With jitter, the retries are spread in time and not done at a fixed interval.
+			Expect(slices.Max(delays) - slices.Min(delays)).To(BeNumerically(">", 0))
+		})
+
+		It("should return conflict error when retries are exhausted", func() {
+			backoff := wait.Backoff{
+				Steps:    conflicts,
+				Duration: time.Millisecond,
+				Factor:   1.0,
+				Jitter:   0.1,
+			}
+
+			_, err := CreateOrUpdate(&request).
+				NamespacedResource(newTestResource(namespace)).
+				Options(ReconcileOptions{ConflictRetryBackoff: &backoff}).
+				UpdateFunc(func(expected, found client.Object) {
+					found.(*v1.Service).Spec = expected.(*v1.Service).Spec
+				}).
+				Reconcile()
+			Expect(err).To(MatchError(errors.IsConflict, "errors.IsConflict"))
+			Expect(updateTimes).To(HaveLen(conflicts))
+		})
+	})
+
+	Context("CreateOrUpdate on concurrent update", func() {
+		var concurrentUpdates int
+
+		BeforeEach(func() {
+			resource := newTestResource(namespace)
+			resource.Spec.Ports[0].Name = "changed-name"
+			Expect(request.Client.Create(request.Context, resource)).To(Succeed())
+
+			concurrentUpdates = 0
+			request.Client = interceptor.NewClient(request.Client.(client.WithWatch), interceptor.Funcs{
+				Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+					if concurrentUpdates == 0 {
+						// Simulate another writer updating the resource between get and update
+						concurrentUpdates++
+						other := &v1.Service{}
+						Expect(c.Get(ctx, client.ObjectKeyFromObject(obj), other)).To(Succeed())
+						other.Labels["concurrent-label"] = "value"
+						Expect(c.Update(ctx, other)).To(Succeed())
+					}
+					return c.Update(ctx, obj, opts...)
+				},
+			})
+		})
+
+		It("should refetch resource and call UpdateFunc again after conflict", func() {
+			var foundLabels []map[string]string
+			_, err := CreateOrUpdate(&request).
+				NamespacedResource(newTestResource(namespace)).
+				UpdateFunc(func(expected, found client.Object) {
+					foundLabels = append(foundLabels, maps.Clone(found.GetLabels()))
+					found.(*v1.Service).Spec = expected.(*v1.Service).Spec
+				}).
+				Reconcile()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(foundLabels).To(HaveLen(2))
+			Expect(foundLabels[0]).ToNot(HaveKey("concurrent-label"))
+			Expect(foundLabels[1]).To(HaveKeyWithValue("concurrent-label", "value"))
+
+			found := &v1.Service{}
+			Expect(request.Client.Get(request.Context, client.ObjectKeyFromObject(newTestResource(namespace)), found)).To(Succeed())
+			Expect(found.Spec.Ports[0].Name).To(Equal("webhook"))
+			Expect(found.Labels).To(HaveKeyWithValue("concurrent-label", "value"))
+		})
+	})
+
+	Context("in read-only mode", func() {
+		var writes []string
+
+		BeforeEach(func() {
+			writes = nil
+			request.ReadOnly = true
+			request.Client = interceptor.NewClient(request.Client.(client.WithWatch), interceptor.Funcs{
+				Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					if len((&client.CreateOptions{}).ApplyOptions(opts).DryRun) == 0 {
+						writes = append(writes, "create "+obj.GetName())
+					}
+					return c.Create(ctx, obj, opts...)
+				},
+				Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+					if len((&client.UpdateOptions{}).ApplyOptions(opts).DryRun) == 0 {
+						writes = append(writes, "update "+obj.GetName())
+					}
+					return c.Update(ctx, obj, opts...)
+				},
+				Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+					if len((&client.DeleteOptions{}).ApplyOptions(opts).DryRun) == 0 {
+						writes = append(writes, "delete "+obj.GetName())
+					}
+					return c.Delete(ctx, obj, opts...)
+				},
+			})
+		})
+
+		It("should not create resource", func() {
+			result, err := createOrUpdateTestResource(&request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.OperationResult).To(Equal(OperationResultCreated))
+
+			err = request.Client.Get(request.Context, client.ObjectKeyFromObject(newTestResource(namespace)), &v1.Service{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(writes).To(BeEmpty())
+		})
+
+		It("should not update resource", func() {
+			resource := newTestResource(namespace)
+			resource.Spec.Ports[0].Name = "changed-name"
+			Expect(request.Client.Create(request.Context, resource)).To(Succeed())
+			writes = nil
+
+			result, err := createOrUpdateTestResource(&request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.OperationResult).To(Equal(OperationResultUpdated))
+			Expect(request.VersionCache.entries).To(BeEmpty())
+
+			found := &v1.Service{}
+			Expect(request.Client.Get(request.Context, client.ObjectKeyFromObject(resource), found)).To(Succeed())
+			Expect(found.Spec.Ports[0].Name).To(Equal("changed-name"))
+			Expect(writes).To(BeEmpty())
+		})
+
+		It("should not delete resource on cleanup", func() {
+			request.ReadOnly = false
+			_, err := createOrUpdateTestResource(&request)
+			Expect(err).ToNot(HaveOccurred())
+			request.ReadOnly = true
+			writes = nil
+
+			cleanupResult, err := Cleanup(&request, newTestResource(namespace))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cleanupResult.Deleted).To(BeFalse())
+
+			expectEqualResourceExists(newTestResource(namespace), &request)
+			Expect(writes).To(BeEmpty())
+		})
+	})
+
+	Context("dry-run", func() {
+		It("should not create resource", func() {
+			result, err := CreateOrUpdate(&request).
+				NamespacedResource(newTestResource(namespace)).
+				DryRun().
+				Reconcile()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.OperationResult).To(Equal(OperationResultCreated))
+
+			err = request.Client.Get(request.Context, client.ObjectKeyFromObject(newTestResource(namespace)), &v1.Service{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should call UpdateFunc and not update resource", func() {
+			resource := newTestResource(namespace)
+			resource.Spec.Ports[0].Name = "changed-name"
+			Expect(request.Client.Create(request.Context, resource)).To(Succeed())
+			// The version is cached, so UpdateFunc would be skipped without dry-run
+			request.VersionCache.Add(resource)
+			Expect(request.VersionCache.entries).To(HaveLen(1))
+
+			updateFuncCalled := false
+			result, err := CreateOrUpdate(&request).
+				NamespacedResource(newTestResource(namespace)).
+				UpdateFunc(func(expected, found client.Object) {
+					updateFuncCalled = true
+					found.(*v1.Service).Spec = expected.(*v1.Service).Spec
+				}).
+				DryRun().
+				Reconcile()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updateFuncCalled).To(BeTrue())
+			Expect(result.OperationResult).To(Equal(OperationResultUpdated))
+
+			found := &v1.Service{}
+			Expect(request.Client.Get(request.Context, client.ObjectKeyFromObject(resource), found)).To(Succeed())
+			Expect(found.Spec.Ports[0].Name).To(Equal("changed-name"))
+		})
+
+		It("should not delete resource on cleanup", func() {
+			_, err := createOrUpdateTestResource(&request)
+			Expect(err).ToNot(HaveOccurred())
+
+			cleanupResults, err := DeleteAllDryRun(&request, newTestResource(namespace))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cleanupResults).To(HaveLen(1))
+			Expect(cleanupResults[0].Deleted).To(BeFalse())
+
+			expectEqualResourceExists(newTestResource(namespace), &request)
+		})
+	})
+
+	Context("CollectResourceStatusParallel", func() {
+		const resourceCount = 20
+
+		newReconcileFuncs := func() []ReconcileFunc {
+			funcs := make([]ReconcileFunc, 0, resourceCount)
+			for i := 0; i < resourceCount; i++ {
+				resource := newTestResource(namespace)
+				resource.Name = fmt.Sprintf("testservice-%d", i)
+				// Later funcs finish sooner, to check the order of results
+				delay := time.Duration(resourceCount-i) * time.Millisecond
+				funcs = append(funcs, func(request *Request) (ReconcileResult, error) {
+					time.Sleep(delay)
+					return CreateOrUpdate(request).
+						NamespacedResource(resource).
+						Reconcile()
+				})
+			}
+			return funcs
+		}
+
+		It("should return results in the order of funcs", func() {
+			results, err := CollectResourceStatusParallel(&request, 4, newReconcileFuncs()...)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(HaveLen(resourceCount))
+			for i, result := range results {
+				Expect(result.Resource.GetName()).To(Equal(fmt.Sprintf("testservice-%d", i)))
+				Expect(result.OperationResult).To(Equal(OperationResultCreated))
+			}
+		})
+
+		It("should return error of the first failed func", func() {
+			funcs := newReconcileFuncs()
+			for _, i := range []int{15, 5} {
+				err := fmt.Errorf("failed func %d", i)
+				funcs[i] = func(*Request) (ReconcileResult, error) {
+					return ReconcileResult{}, err
+				}
+			}
+
+			_, err := CollectResourceStatusParallel(&request, 0, funcs...)
+			Expect(err).To(MatchError("failed func 5"))
+		})
+	})
+
+	Context("with cancelled context", func() {
+		var cancel context.CancelFunc
+
+		BeforeEach(func() {
+			request.Context, cancel = context.WithCancel(request.Context)
+		})
+
+		AfterEach(func() {
+			cancel()
+		})
+
+		It("should stop CollectResourceStatus when context is cancelled", func() {
+			calls := 0
+			funcs := []ReconcileFunc{
+				func(request *Request) (ReconcileResult, error) {
+					calls++
+					return createOrUpdateTestResource(request)
+				},
+				func(request *Request) (ReconcileResult, error) {
+					calls++
+					cancel()
+					return ReconcileResult{}, nil
+				},
+				func(request *Request) (ReconcileResult, error) {
+					calls++
+					return ReconcileResult{}, nil
+				},
+			}
+
+			start := time.Now()
+			_, err := CollectResourceStatus(&request, funcs...)
+			Expect(err).To(MatchError(context.Canceled))
+			Expect(calls).To(Equal(2))
+			Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+		})
+
+		It("should fail CreateOrUpdate when context is cancelled", func() {
+			cancel()
+			_, err := createOrUpdateTestResource(&request)
+			Expect(err).To(MatchError(context.Canceled))
+
+			err = request.Client.Get(context.Background(), client.ObjectKeyFromObject(newTestResource(namespace)), &v1.Service{})
+			Expect(err).To(MatchError(errors.IsNotFound, "errors.IsNotFound"))
+		})
+
+		It("should stop DeleteAll when context is cancelled", func() {
+			_, err := createOrUpdateTestResource(&request)
+			Expect(err).ToNot(HaveOccurred())
+
+			cancel()
+			_, err = DeleteAll(&request, newTestResource(namespace))
+			Expect(err).To(MatchError(context.Canceled))
+
+			Expect(request.Client.Get(context.Background(), client.ObjectKeyFromObject(newTestResource(namespace)), &v1.Service{})).To(Succeed())
+		})
+	})
+
+	Context("CollectResourceStatusWithRetry", func() {
+		var backoff wait.Backoff
+
+		BeforeEach(func() {
+			backoff = wait.Backoff{
+				Duration: time.Millisecond,
+				Factor:   2,
+				Steps:    4,
+			}
+		})
+
+		failingFunc := func(calls *int, failures int, err error) ReconcileFunc {
+			return func(request *Request) (ReconcileResult, error) {
+				*calls++
+				if *calls <= failures {
+					return ReconcileResult{}, err
+				}
+				return createOrUpdateTestResource(request)
+			}
+		}
+
+		tooManyRequestsErr := errors.NewTooManyRequests("too many requests", 1)
+
+		DescribeTable("should retry transient error", func(transientErr error) {
+			calls := 0
+			results, err := CollectResourceStatusWithRetry(&request, backoff, failingFunc(&calls, 2, transientErr))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(HaveLen(1))
+			Expect(calls).To(Equal(3))
+		},
+			Entry("too many requests", tooManyRequestsErr),
+			Entry("server timeout", errors.NewServerTimeout(v1.Resource("services"), "get", 1)),
+			Entry("timeout", errors.NewTimeoutError("timeout", 1)),
+		)
+
+		It("should not retry other errors", func() {
+			calls := 0
+			_, err := CollectResourceStatusWithRetry(&request, backoff, failingFunc(&calls, 1, fmt.Errorf("test error")))
+			Expect(err).To(MatchError("test error"))
+			Expect(calls).To(Equal(1))
+		})
+
+		It("should not retry conflict error", func() {
+			calls := 0
+			conflictErr := errors.NewConflict(v1.Resource("services"), "testservice", fmt.Errorf("conflict"))
+			_, err := CollectResourceStatusWithRetry(&request, backoff, failingFunc(&calls, 1, conflictErr))
+			Expect(err).To(MatchError(errors.IsConflict, "errors.IsConflict"))
+			Expect(calls).To(Equal(1))
+		})
+
+		It("should return last error when retries are exhausted", func() {
+			calls := 0
+			_, err := CollectResourceStatusWithRetry(&request, backoff, failingFunc(&calls, 10, tooManyRequestsErr))
+			Expect(err).To(MatchError(errors.IsTooManyRequests, "errors.IsTooManyRequests"))
+			Expect(calls).To(Equal(backoff.Steps))
+		})
+
+		It("should stop retrying when context is done", func() {
+			ctx, cancel := context.WithCancel(request.Context)
+			request.Context = ctx
+
+			calls := 0
+			_, err := CollectResourceStatusWithRetry(&request, backoff, func(request *Request) (ReconcileResult, error) {
+				calls++
+				cancel()
+				return ReconcileResult{}, tooManyRequestsErr
+			})
+			Expect(err).To(MatchError(context.Canceled))
+			Expect(calls).To(Equal(1))
+		})
+
+		It("should return context error when cancelled during backoff", func() {
+			ctx, cancel := context.WithCancel(request.Context)
+			defer cancel()
+			request.Context = ctx
+			backoff.Duration = time.Hour
+
+			calls := 0
+			time.AfterFunc(10*time.Millisecond, cancel)
+			_, err := CollectResourceStatusWithRetry(&request, backoff, failingFunc(&calls, 10, tooManyRequestsErr))
+			Expect(err).To(MatchError(context.Canceled))
+			Expect(calls).To(Equal(1))
+		})
+	})
+
 	Context("Cleanup", func() {
 		It("should succeed Cleanup, if no resource is present", func() {
 			nonexistingResource := newTestResource(namespace)
@@ -290,6 +952,58 @@ var _ = Describe("Resource", func() {
 			Expect(cleanupResult.Deleted).To(BeTrue())
 		})
 	})
+
+	Context("DeleteInOrder", func() {
+		var (
+			firstResource  *v1.Service
+			secondResource *v1.Service
+		)
+
+		BeforeEach(func() {
+			firstResource = newTestResource(namespace)
+			firstResource.Name = "testservice-first"
+			secondResource = newTestResource(namespace)
+			secondResource.Name = "testservice-second"
+
+			for _, resource := range []*v1.Service{firstResource, secondResource} {
+				_, err := CreateOrUpdate(&request).
+					NamespacedResource(resource.DeepCopy()).
+					Reconcile()
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		It("should not delete next group until previous group is deleted", func() {
+			results, err := DeleteInOrder(&request, []client.Object{firstResource}, []client.Object{secondResource})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].Deleted).To(BeFalse())
+
+			found := &v1.Service{}
+			Expect(request.Client.Get(request.Context, client.ObjectKeyFromObject(secondResource), found)).To(Succeed())
+			Expect(found.GetDeletionTimestamp().IsZero()).To(BeTrue(), "Deletion timestamp should not be set")
+
+			results, err = DeleteInOrder(&request, []client.Object{firstResource}, []client.Object{secondResource})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(HaveLen(2))
+			Expect(results[0].Deleted).To(BeTrue())
+			Expect(results[1].Deleted).To(BeFalse())
+
+			err = request.Client.Get(request.Context, client.ObjectKeyFromObject(secondResource), &v1.Service{})
+			Expect(err).To(MatchError(errors.IsNotFound, "errors.IsNotFound"))
+		})
+
+		It("should succeed when resources are already deleted", func() {
+			Expect(request.Client.Delete(request.Context, firstResource.DeepCopy())).To(Succeed())
+			Expect(request.Client.Delete(request.Context, secondResource.DeepCopy())).To(Succeed())
+
+			results, err := DeleteInOrder(&request, []client.Object{firstResource}, []client.Object{secondResource})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(HaveLen(2))
+			Expect(results[0].Deleted).To(BeTrue())
+			Expect(results[1].Deleted).To(BeTrue())
+		})
+	})
 })
 
 func createOrUpdateTestResource(request *Request) (ReconcileResult, error) {
@@ -342,3 +1056,75 @@ func expectEqualResourceExists(resource client.Object, request *Request) {
 
 	ExpectWithOffset(1, found).To(Equal(resource))
 }
+
+func BenchmarkCollectResourceStatus(b *testing.B) {
+	benchmarkCollectResourceStatus(b, func(request *Request, funcs ...ReconcileFunc) ([]ReconcileResult, error) {
+		return CollectResourceStatus(request, funcs...)
+	})
+}
+
+func BenchmarkCollectResourceStatusParallel(b *testing.B) {
+	benchmarkCollectResourceStatus(b, func(request *Request, funcs ...ReconcileFunc) ([]ReconcileResult, error) {
+		return CollectResourceStatusParallel(request, 16, funcs...)
+	})
+}
+
+func benchmarkCollectResourceStatus(b *testing.B, collectFunc func(*Request, ...ReconcileFunc) ([]ReconcileResult, error)) {
+	const (
+		resourceCount = 200
+		// Simulated latency of the API server
+		apiLatency = time.Millisecond
+	)
+
+	s := scheme.Scheme
+	if err := ssp.AddToScheme(s); err != nil {
+		b.Fatal(err)
+	}
+
+	funcs := make([]ReconcileFunc, 0, resourceCount)
+	for i := 0; i < resourceCount; i++ {
+		resource := newTestResource(namespace)
+		resource.Name = fmt.Sprintf("testservice-%d", i)
+		funcs = append(funcs, func(request *Request) (ReconcileResult, error) {
+			return CreateOrUpdate(request).
+				NamespacedResource(resource.DeepCopy()).
+				Reconcile()
+		})
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		b.StopTimer()
+		slowClient := interceptor.NewClient(fake.NewClientBuilder().WithScheme(s).Build(), interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				time.Sleep(apiLatency)
+				return c.Get(ctx, key, obj, opts...)
+			},
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				time.Sleep(apiLatency)
+				return c.Create(ctx, obj, opts...)
+			},
+		})
+		request := &Request{
+			Client:  slowClient,
+			Context: context.Background(),
+			Instance: &ssp.SSP{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       sspResourceKind,
+					APIVersion: ssp.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+			},
+			Logger:       log,
+			VersionCache: NewVersionCache(),
+		}
+		b.StartTimer()
+
+		if _, err := collectFunc(request, funcs...); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
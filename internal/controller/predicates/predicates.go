@@ -3,6 +3,7 @@ package predicates
 import (
 	"reflect"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -34,3 +35,31 @@ func getSpec(obj client.Object) (interface{}, bool) {
 	}
 	return specVal.Interface(), true
 }
+
+// LabelSelectorPredicate filters events of objects that do not match the selector.
+// Update events pass if the old or the new object matches, so that the removal
+// of matching labels is not missed.
+type LabelSelectorPredicate struct {
+	predicate.Funcs
+	Selector labels.Selector
+}
+
+func (p LabelSelectorPredicate) Create(e event.CreateEvent) bool {
+	return p.matches(e.Object)
+}
+
+func (p LabelSelectorPredicate) Delete(e event.DeleteEvent) bool {
+	return p.matches(e.Object)
+}
+
+func (p LabelSelectorPredicate) Update(e event.UpdateEvent) bool {
+	return p.matches(e.ObjectOld) || p.matches(e.ObjectNew)
+}
+
+func (p LabelSelectorPredicate) Generic(e event.GenericEvent) bool {
+	return p.matches(e.Object)
+}
+
+func (p LabelSelectorPredicate) matches(obj client.Object) bool {
+	return obj != nil && p.Selector.Matches(labels.Set(obj.GetLabels()))
+}
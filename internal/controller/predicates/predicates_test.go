@@ -7,6 +7,8 @@ import (
 	. "github.com/onsi/gomega"
 
 	v1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 
@@ -63,6 +65,54 @@ var _ = Describe("SpecChangedPredicate", func() {
 	})
 })
 
+var _ = Describe("LabelSelectorPredicate", func() {
+	var (
+		pred       LabelSelectorPredicate
+		matching   *v1.Role
+		unmatching *v1.Role
+	)
+
+	BeforeEach(func() {
+		pred = LabelSelectorPredicate{
+			Selector: labels.SelectorFromSet(labels.Set{"test-label": "test-value"}),
+		}
+
+		matching = &v1.Role{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "matching",
+				Labels: map[string]string{"test-label": "test-value"},
+			},
+		}
+		unmatching = &v1.Role{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "unmatching",
+				Labels: map[string]string{"test-label": "other-value"},
+			},
+		}
+	})
+
+	It("Should be true for matching object", func() {
+		Expect(pred.Create(event.CreateEvent{Object: matching})).To(BeTrue())
+		Expect(pred.Delete(event.DeleteEvent{Object: matching})).To(BeTrue())
+		Expect(pred.Generic(event.GenericEvent{Object: matching})).To(BeTrue())
+		Expect(pred.Update(event.UpdateEvent{ObjectOld: matching, ObjectNew: matching.DeepCopy()})).To(BeTrue())
+	})
+
+	It("Should be false for not matching object", func() {
+		Expect(pred.Create(event.CreateEvent{Object: unmatching})).To(BeFalse())
+		Expect(pred.Delete(event.DeleteEvent{Object: unmatching})).To(BeFalse())
+		Expect(pred.Generic(event.GenericEvent{Object: unmatching})).To(BeFalse())
+		Expect(pred.Update(event.UpdateEvent{ObjectOld: unmatching, ObjectNew: unmatching.DeepCopy()})).To(BeFalse())
+	})
+
+	It("Should be true if labels were removed", func() {
+		newObj := matching.DeepCopy()
+		newObj.Labels = nil
+
+		Expect(pred.Update(event.UpdateEvent{ObjectOld: matching, ObjectNew: newObj})).To(BeTrue())
+	})
+})
+
 func TestPredicates(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Predicates Suite")
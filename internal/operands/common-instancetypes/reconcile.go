@@ -199,7 +199,7 @@ func reconcileRemovedInstancetypes(request *common.Request, existingResources []
 	for _, existingResource := range existingResources {
 		if _, resourceProvided := resourceFromURLByName[existingResource.Name]; !resourceProvided {
 			request.Logger.Info(fmt.Sprintf("removing the no longer provided %s VirtualMachineClusterInstancetype", existingResource.Name))
-			if err := request.Client.Delete(request.Context, &existingResource); err != nil {
+			if err := request.WriteClient().Delete(request.Context, &existingResource); err != nil {
 				return err
 			}
 		}
@@ -215,7 +215,7 @@ func reconcileRemovedPreferences(request *common.Request, existingResources []in
 	for _, existingResource := range existingResources {
 		if _, resourceProvided := resourceFromURLByName[existingResource.Name]; !resourceProvided {
 			request.Logger.Info(fmt.Sprintf("removing the no longer provided %s VirtualMachineClusterPreference", existingResource.Name))
-			if err := request.Client.Delete(request.Context, &existingResource); err != nil {
+			if err := request.WriteClient().Delete(request.Context, &existingResource); err != nil {
 				return err
 			}
 		}
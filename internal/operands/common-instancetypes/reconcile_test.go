@@ -133,7 +133,7 @@ var _ = Describe("Common-Instancetypes operand", func() {
 				},
 			},
 			Logger:       log,
-			VersionCache: common.VersionCache{},
+			VersionCache: common.NewVersionCache(),
 			CrdList:      crdWatch,
 		}
 	})
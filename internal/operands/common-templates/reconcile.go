@@ -10,6 +10,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/blang/semver/v4"
@@ -68,7 +69,7 @@ func (c *commonTemplates) WatchTypes() []operands.WatchType {
 }
 
 func (c *commonTemplates) Reconcile(request *common.Request) ([]common.ReconcileResult, error) {
-	reconcileTemplatesResults, err := common.CollectResourceStatus(request, reconcileTemplatesFuncs(c.templatesBundle)...)
+	reconcileTemplatesResults, err := common.CollectResourceStatusWithRetry(request, retry.DefaultBackoff, reconcileTemplatesFuncs(c.templatesBundle)...)
 	if err != nil {
 		return nil, err
 	}
@@ -82,7 +83,7 @@ func (c *commonTemplates) Reconcile(request *common.Request) ([]common.Reconcile
 		return nil, err
 	}
 
-	oldTemplatesResults, err := common.CollectResourceStatus(request, oldTemplateFuncs...)
+	oldTemplatesResults, err := common.CollectResourceStatusWithRetry(request, retry.DefaultBackoff, oldTemplateFuncs...)
 	if err != nil {
 		return nil, err
 	}
@@ -102,6 +103,7 @@ func incrementTemplatesRestoredMetric(reconcileResults []common.ReconcileResult,
 
 			if reconcileResult.OperationResult == common.OperationResultUpdated && oldVersion == newVersion {
 				logger.Info(fmt.Sprintf("Changes reverted in common template: %s", reconcileResult.Resource.GetName()))
+				logger.V(1).Info("Reverted changes", "template", reconcileResult.Resource.GetName(), "diff", reconcileResult.Diff)
 				metrics.IncCommonTemplatesRestored()
 			}
 		}
@@ -90,7 +90,7 @@ var _ = Describe("Common-Templates operand", func() {
 			},
 			InstanceChanged: false,
 			Logger:          log,
-			VersionCache:    common.VersionCache{},
+			VersionCache:    common.NewVersionCache(),
 		}
 	})
 
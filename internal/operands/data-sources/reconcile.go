@@ -361,7 +361,7 @@ func reconcileDataSources(dataSourceInfos []dataSourceInfo, request *common.Requ
 				return common.ResourceDeletedResult(&dataSource, common.OperationResultDeleted), nil
 			}
 
-			err := request.Client.Delete(request.Context, &dataSource)
+			err := request.WriteClient().Delete(request.Context, &dataSource)
 			if errors.IsNotFound(err) {
 				return common.ReconcileResult{
 					Resource: &dataSource,
@@ -451,7 +451,7 @@ func reconcileDataImportCrons(dataImportCrons []cdiv1beta1.DataImportCron, reque
 
 		cron := ownedCrons[i] // Make local copy
 		funcs = append(funcs, func(request *common.Request) (common.ReconcileResult, error) {
-			err := request.Client.Delete(request.Context, &cron)
+			err := request.WriteClient().Delete(request.Context, &cron)
 			if err != nil && !errors.IsNotFound(err) {
 				request.Logger.Error(err, fmt.Sprintf("Error deleting \"%s\": %s", cron.GetName(), err))
 				return common.ReconcileResult{}, err
@@ -71,7 +71,7 @@ var _ = Describe("Data-Sources operand", func() {
 				},
 			},
 			Logger:       log,
-			VersionCache: common.VersionCache{},
+			VersionCache: common.NewVersionCache(),
 		}
 	})
 
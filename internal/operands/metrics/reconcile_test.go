@@ -58,7 +58,7 @@ var _ = Describe("Metrics operand", func() {
 				},
 			},
 			Logger:       log,
-			VersionCache: common.VersionCache{},
+			VersionCache: common.NewVersionCache(),
 		}
 	})
 
@@ -1,6 +1,7 @@
 package operands
 
 import (
+	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"kubevirt.io/ssp-operator/internal/common"
@@ -24,6 +25,35 @@ type Operand interface {
 	Name() string
 }
 
+// HealthChecker can optionally be implemented by an Operand, to contribute
+// to the readiness probe of the operator. Operands that do not implement it
+// are considered healthy.
+type HealthChecker interface {
+	// Health returns an error if the operand is not functioning.
+	// It is called on every readiness probe, so it must be cheap,
+	// preferably reading only from the cache, and must not modify any resources.
+	Health(*common.Request) error
+}
+
+// DefaultPriority is the priority of operands that do not implement Prioritized.
+const DefaultPriority = 0
+
+// Prioritized can optionally be implemented by an Operand, to be reconciled
+// before or after other operands. Operands with lower priority are reconciled first.
+// Operands with equal priority keep the order in which they were registered.
+type Prioritized interface {
+	Priority() int
+}
+
+// Priority returns the priority of the operand, or DefaultPriority
+// if the operand does not implement Prioritized.
+func Priority(operand Operand) int {
+	if prioritized, ok := operand.(Prioritized); ok {
+		return prioritized.Priority()
+	}
+	return DefaultPriority
+}
+
 type WatchType struct {
 	Object client.Object
 
@@ -34,4 +64,8 @@ type WatchType struct {
 	// Otherwise, only these changes in spec, labels, and annotations.
 	// If an object does not have spec field, the full object is watched by default.
 	WatchFullObject bool
+
+	// LabelSelector specifies that the operator should only watch for changes
+	// in objects matching the selector. If nil, all objects are watched.
+	LabelSelector labels.Selector
 }
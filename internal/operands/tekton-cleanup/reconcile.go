@@ -2,10 +2,13 @@ package tekton_cleanup
 
 import (
 	"fmt"
+	"sync"
 
+	pipelinev1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	pipeline "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	v1 "k8s.io/api/core/v1"
 	rbac "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -46,21 +49,31 @@ const (
 
 func init() {
 	utilruntime.Must(pipeline.AddToScheme(common.Scheme))
+	utilruntime.Must(pipelinev1.AddToScheme(common.Scheme))
 }
 
 func WatchClusterTypes() []operands.WatchType {
+	// Only resources created by SSP are cleaned up, so other resources do not need to be watched.
+	managedBySelector := labels.SelectorFromSet(labels.Set{
+		common.AppKubernetesManagedByLabel: common.AppKubernetesManagedByValue,
+	})
 	return []operands.WatchType{
-		{Object: &v1.ConfigMap{}},
-		{Object: &rbac.ClusterRole{}},
-		{Object: &rbac.RoleBinding{}},
-		{Object: &v1.ServiceAccount{}},
+		{Object: &v1.ConfigMap{}, LabelSelector: managedBySelector},
+		{Object: &rbac.ClusterRole{}, LabelSelector: managedBySelector},
+		{Object: &rbac.RoleBinding{}, LabelSelector: managedBySelector},
+		{Object: &v1.ServiceAccount{}, LabelSelector: managedBySelector},
 	}
 }
 
-type tektonCleanup struct{}
+type tektonCleanup struct {
+	lock             sync.Mutex
+	lastReconcileErr error
+}
 
 var _ operands.Operand = &tektonCleanup{}
 
+var _ operands.HealthChecker = &tektonCleanup{}
+
 func New() operands.Operand {
 	return &tektonCleanup{}
 }
@@ -77,7 +90,36 @@ func (t *tektonCleanup) WatchTypes() []operands.WatchType {
 	return nil
 }
 
+// Health returns an error if the last reconciliation failed,
+// or if the Tekton CRD exists, but its served API version cannot be determined.
+func (t *tektonCleanup) Health(request *common.Request) error {
+	t.lock.Lock()
+	lastReconcileErr := t.lastReconcileErr
+	t.lock.Unlock()
+
+	if lastReconcileErr != nil {
+		return fmt.Errorf("last reconciliation failed: %w", lastReconcileErr)
+	}
+
+	if request.CrdList.CrdExists(tektonCrd) {
+		if _, err := tektonV1Served(request); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (t *tektonCleanup) Reconcile(request *common.Request) ([]common.ReconcileResult, error) {
+	err := t.reconcile(request)
+
+	t.lock.Lock()
+	t.lastReconcileErr = err
+	t.lock.Unlock()
+
+	return nil, err
+}
+
+func (t *tektonCleanup) reconcile(request *common.Request) error {
 	deprecateFuncs := []func(*common.Request) error{
 		deprecateResource[rbac.ClusterRoleList, rbac.ClusterRole],
 		deprecateResource[rbac.RoleBindingList, rbac.RoleBinding],
@@ -85,41 +127,70 @@ func (t *tektonCleanup) Reconcile(request *common.Request) ([]common.ReconcileRe
 		deprecateResource[v1.ConfigMapList, v1.ConfigMap],
 	}
 	if request.CrdList.CrdExists(tektonCrd) {
-		deprecateFuncs = append(deprecateFuncs, deprecateResource[pipeline.PipelineList, pipeline.Pipeline]) //nolint:staticcheck
-		deprecateFuncs = append(deprecateFuncs, deprecateResource[pipeline.TaskList, pipeline.Task])         //nolint:staticcheck
+		servesV1, err := tektonV1Served(request)
+		if err != nil {
+			return err
+		}
+		if servesV1 {
+			deprecateFuncs = append(deprecateFuncs, deprecateResource[pipelinev1.PipelineList, pipelinev1.Pipeline])
+			deprecateFuncs = append(deprecateFuncs, deprecateResource[pipelinev1.TaskList, pipelinev1.Task])
+		} else {
+			deprecateFuncs = append(deprecateFuncs, deprecateResource[pipeline.PipelineList, pipeline.Pipeline]) //nolint:staticcheck
+			deprecateFuncs = append(deprecateFuncs, deprecateResource[pipeline.TaskList, pipeline.Task])         //nolint:staticcheck
+		}
 	}
 
 	for _, deprecate := range deprecateFuncs {
 		if err := deprecate(request); err != nil {
-			return nil, err
+			return err
 		}
 	}
 
-	return nil, nil
+	return nil
 }
 
 func (t *tektonCleanup) Cleanup(request *common.Request) ([]common.CleanupResult, error) {
-	cleanupFuncs := []func(*common.Request) ([]common.CleanupResult, error){
-		cleanupResource[rbac.ClusterRoleList, rbac.ClusterRole],
-		cleanupResource[rbac.RoleBindingList, rbac.RoleBinding],
-		cleanupResource[v1.ServiceAccountList, v1.ServiceAccount],
-		cleanupResource[v1.ConfigMapList, v1.ConfigMap],
+	// Resources are deleted in order of their dependencies, so that for example
+	// ServiceAccounts are not deleted before Pipelines that use them.
+	listFuncGroups := [][]func(*common.Request) ([]client.Object, error){
+		nil, // Pipelines and Tasks, if the CRD exists
+		{listResources[v1.ConfigMapList, v1.ConfigMap]},
+		{listResources[rbac.RoleBindingList, rbac.RoleBinding]},
+		{listResources[v1.ServiceAccountList, v1.ServiceAccount]},
+		{listResources[rbac.ClusterRoleList, rbac.ClusterRole]},
 	}
 	if request.CrdList.CrdExists(tektonCrd) {
-		cleanupFuncs = append(cleanupFuncs, cleanupResource[pipeline.PipelineList, pipeline.Pipeline]) //nolint:staticcheck
-		cleanupFuncs = append(cleanupFuncs, cleanupResource[pipeline.TaskList, pipeline.Task])         //nolint:staticcheck
-	}
-
-	var allResults []common.CleanupResult
-	for _, cleanupFunc := range cleanupFuncs {
-		cleanupResults, err := cleanupFunc(request)
+		servesV1, err := tektonV1Served(request)
 		if err != nil {
 			return nil, err
 		}
-		allResults = append(allResults, cleanupResults...)
+		if servesV1 {
+			listFuncGroups[0] = []func(*common.Request) ([]client.Object, error){
+				listResources[pipelinev1.PipelineList, pipelinev1.Pipeline],
+				listResources[pipelinev1.TaskList, pipelinev1.Task],
+			}
+		} else {
+			listFuncGroups[0] = []func(*common.Request) ([]client.Object, error){
+				listResources[pipeline.PipelineList, pipeline.Pipeline], //nolint:staticcheck
+				listResources[pipeline.TaskList, pipeline.Task],         //nolint:staticcheck
+			}
+		}
+	}
+
+	groups := make([][]client.Object, 0, len(listFuncGroups))
+	for _, listFuncs := range listFuncGroups {
+		var group []client.Object
+		for _, listFunc := range listFuncs {
+			resources, err := listFunc(request)
+			if err != nil {
+				return nil, err
+			}
+			group = append(group, resources...)
+		}
+		groups = append(groups, group)
 	}
 
-	return allResults, nil
+	return common.DeleteInOrder(request, groups...)
 }
 
 func deprecateResource[L any, T any, PtrL interface {
@@ -141,35 +212,43 @@ func deprecateResource[L any, T any, PtrL interface {
 		}
 
 		resource.GetAnnotations()[tektonDeprecated] = "true"
-		if err := request.Client.Update(request.Context, resource); err != nil {
+		if err := request.WriteClient().Update(request.Context, resource); err != nil {
 			return fmt.Errorf("failed to update %s: %w", resource.GetObjectKind().GroupVersionKind().Kind, err)
 		}
 	}
 	return nil
 }
 
-func cleanupResource[L any, T any, PtrL interface {
+func listResources[L any, T any, PtrL interface {
 	*L
 	client.ObjectList
 }, PtrT interface {
 	*T
 	client.Object
-}](request *common.Request) ([]common.CleanupResult, error) {
+}](request *common.Request) ([]client.Object, error) {
 	resources, err := common.ListOwnedResources[L, T, PtrL, PtrT](request, matchingLabelsOption(request.Instance))
 	if err != nil {
 		return nil, fmt.Errorf("failed to list owned resources: %w", err)
 	}
 
-	results := make([]common.CleanupResult, 0, len(resources))
+	objects := make([]client.Object, 0, len(resources))
 	for i := range resources {
-		resource := PtrT(&resources[i])
-		cleanupResult, err := common.Cleanup(request, resource)
-		if err != nil {
-			return nil, fmt.Errorf("failed to cleanup resource: %w", err)
-		}
-		results = append(results, cleanupResult)
+		objects = append(objects, PtrT(&resources[i]))
+	}
+	return objects, nil
+}
+
+// tektonV1Served returns true if the cluster serves Tekton resources in version v1.
+// Newer Tekton releases deprecated v1beta1, and older releases do not serve v1.
+func tektonV1Served(request *common.Request) (bool, error) {
+	_, err := request.Client.RESTMapper().RESTMapping(pipelinev1.Kind("Pipeline"), pipelinev1.SchemeGroupVersion.Version)
+	if meta.IsNoMatchError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check served Tekton API version: %w", err)
 	}
-	return results, nil
+	return true, nil
 }
 
 func matchingLabelsOption(ssp *ssp.SSP) client.MatchingLabelsSelector {
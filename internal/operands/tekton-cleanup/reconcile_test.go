@@ -2,6 +2,7 @@ package tekton_cleanup
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -9,16 +10,19 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 
 	libhandler "github.com/operator-framework/operator-lib/handler"
+	pipelinev1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	pipeline "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	v1 "k8s.io/api/core/v1"
 	rbac "k8s.io/api/rbac/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	internalmeta "k8s.io/apimachinery/pkg/apis/meta/internalversion"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -51,6 +55,34 @@ var _ = Describe("tekton-cleanup operand", func() {
 		Expect(name).To(Equal(operandName), "should return correct name")
 	})
 
+	Context("health", func() {
+		It("should be healthy after successful reconcile", func() {
+			_, err := operand.Reconcile(request)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(operand.(operands.HealthChecker).Health(request)).To(Succeed())
+		})
+
+		It("should not be healthy when the last reconcile failed", func() {
+			originalClient := request.Client
+			listErr := fmt.Errorf("test list error")
+			request.Client = interceptor.NewClient(originalClient.(client.WithWatch), interceptor.Funcs{
+				List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+					return listErr
+				},
+			})
+
+			_, err := operand.Reconcile(request)
+			Expect(err).To(MatchError(listErr))
+			Expect(operand.(operands.HealthChecker).Health(request)).To(MatchError(listErr))
+
+			request.Client = originalClient
+			_, err = operand.Reconcile(request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(operand.(operands.HealthChecker).Health(request)).To(Succeed())
+		})
+	})
+
 	Context("with old Pipelines resources in cluster", func() {
 		const (
 			resourceName = "test-tekton"
@@ -102,10 +134,9 @@ var _ = Describe("tekton-cleanup operand", func() {
 		)
 
 		DescribeTable("should delete resource on Cleanup", func(obj client.Object) {
-			_, err := operand.Cleanup(request)
-			Expect(err).ToNot(HaveOccurred())
+			cleanupUntilDeleted(operand, request)
 
-			err = request.Client.Get(request.Context, client.ObjectKey{Namespace: namespace, Name: resourceName}, obj)
+			err := request.Client.Get(request.Context, client.ObjectKey{Namespace: namespace, Name: resourceName}, obj)
 			Expect(err).To(MatchError(errors.IsNotFound, "errors.IsNotFound"))
 		},
 			Entry("ClusterRoles", &rbac.ClusterRole{}),
@@ -114,6 +145,163 @@ var _ = Describe("tekton-cleanup operand", func() {
 			Entry("ConfigMaps", &v1.ConfigMap{}),
 			Entry("Pipelines", &pipeline.Pipeline{}), //nolint:staticcheck
 		)
+
+		It("should delete Pipelines before other resources", func() {
+			results, err := operand.Cleanup(request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].Resource).To(BeAssignableToTypeOf(&pipeline.Pipeline{})) //nolint:staticcheck
+
+			key := client.ObjectKey{Namespace: namespace, Name: resourceName}
+			Expect(request.Client.Get(request.Context, key, &pipeline.Pipeline{})).To(MatchError(errors.IsNotFound, "errors.IsNotFound")) //nolint:staticcheck
+			Expect(request.Client.Get(request.Context, key, &v1.ConfigMap{})).To(Succeed())
+			Expect(request.Client.Get(request.Context, key, &rbac.RoleBinding{})).To(Succeed())
+			Expect(request.Client.Get(request.Context, key, &v1.ServiceAccount{})).To(Succeed())
+			Expect(request.Client.Get(request.Context, key, &rbac.ClusterRole{})).To(Succeed())
+		})
+
+		It("should not delete other resources while Pipeline is being deleted", func() {
+			key := client.ObjectKey{Namespace: namespace, Name: resourceName}
+			foundPipeline := &pipeline.Pipeline{} //nolint:staticcheck
+			Expect(request.Client.Get(request.Context, key, foundPipeline)).To(Succeed())
+			foundPipeline.Finalizers = append(foundPipeline.Finalizers, "test-finalizer")
+			Expect(request.Client.Update(request.Context, foundPipeline)).To(Succeed())
+
+			for i := 0; i < 3; i++ {
+				results, err := operand.Cleanup(request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(results).To(HaveLen(1))
+				Expect(results[0].Deleted).To(BeFalse())
+			}
+
+			Expect(request.Client.Get(request.Context, key, &v1.ConfigMap{})).To(Succeed())
+			Expect(request.Client.Get(request.Context, key, &rbac.RoleBinding{})).To(Succeed())
+			Expect(request.Client.Get(request.Context, key, &v1.ServiceAccount{})).To(Succeed())
+			Expect(request.Client.Get(request.Context, key, &rbac.ClusterRole{})).To(Succeed())
+		})
+
+		It("should finish Cleanup when some resources are already deleted", func() {
+			key := client.ObjectKey{Namespace: namespace, Name: resourceName}
+			for _, obj := range []client.Object{&v1.ConfigMap{}, &v1.ServiceAccount{}} {
+				Expect(request.Client.Get(request.Context, key, obj)).To(Succeed())
+				Expect(request.Client.Delete(request.Context, obj)).To(Succeed())
+			}
+
+			cleanupUntilDeleted(operand, request)
+
+			results, err := operand.Cleanup(request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(BeEmpty())
+		})
+	})
+
+	Context("with foreign ConfigMaps in cluster", func() {
+		const (
+			resourceName = "test-tekton"
+		)
+
+		It("should not delete ConfigMap without SSP labels", func() {
+			configMap := &v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      resourceName,
+					Annotations: map[string]string{
+						libhandler.NamespacedNameAnnotation: types.NamespacedName{
+							Namespace: request.Instance.Namespace,
+							Name:      request.Instance.Name,
+						}.String(),
+						libhandler.TypeAnnotation: request.Instance.GroupVersionKind().GroupKind().String(),
+					},
+				},
+			}
+			Expect(request.Client.Create(request.Context, configMap)).To(Succeed())
+
+			_, err := operand.Cleanup(request)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(request.Client.Get(request.Context, client.ObjectKeyFromObject(configMap), &v1.ConfigMap{})).To(Succeed())
+		})
+
+		It("should not delete ConfigMap not owned by SSP", func() {
+			configMap := &v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      resourceName,
+					Labels: map[string]string{
+						common.AppKubernetesNameLabel:      operandPipelinesName,
+						common.AppKubernetesComponentLabel: common.AppComponentTektonPipelines.String(),
+						common.AppKubernetesManagedByLabel: common.AppKubernetesManagedByValue,
+						common.AppKubernetesPartOfLabel:    sspPartOfValue,
+					},
+				},
+			}
+			Expect(request.Client.Create(request.Context, configMap)).To(Succeed())
+
+			_, err := operand.Cleanup(request)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(request.Client.Get(request.Context, client.ObjectKeyFromObject(configMap), &v1.ConfigMap{})).To(Succeed())
+		})
+	})
+
+	Context("with old Tekton v1 resources in cluster", func() {
+		const (
+			resourceName = "test-tekton"
+		)
+
+		BeforeEach(func() {
+			restMapper := meta.NewDefaultRESTMapper(nil)
+			restMapper.Add(pipelinev1.SchemeGroupVersion.WithKind("Pipeline"), meta.RESTScopeNamespace)
+			restMapper.Add(pipelinev1.SchemeGroupVersion.WithKind("Task"), meta.RESTScopeNamespace)
+			request = getMockedRequestWithRESTMapper(restMapper)
+
+			commonObjectMeta := metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      resourceName,
+				Annotations: map[string]string{
+					libhandler.NamespacedNameAnnotation: types.NamespacedName{
+						Namespace: request.Instance.Namespace,
+						Name:      request.Instance.Name,
+					}.String(),
+					libhandler.TypeAnnotation: request.Instance.GroupVersionKind().GroupKind().String(),
+				},
+				Labels: map[string]string{
+					common.AppKubernetesNameLabel:      operandPipelinesName,
+					common.AppKubernetesComponentLabel: common.AppComponentTektonPipelines.String(),
+					common.AppKubernetesManagedByLabel: common.AppKubernetesManagedByValue,
+					common.AppKubernetesPartOfLabel:    sspPartOfValue,
+				},
+			}
+
+			for _, resource := range []client.Object{
+				&pipelinev1.Pipeline{ObjectMeta: commonObjectMeta},
+				&pipelinev1.Task{ObjectMeta: commonObjectMeta},
+			} {
+				Expect(request.Client.Create(request.Context, resource)).To(Succeed())
+			}
+		})
+
+		DescribeTable("should add deprecated annotation", func(obj client.Object) {
+			_, err := operand.Reconcile(request)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(request.Client.Get(request.Context, client.ObjectKey{Namespace: namespace, Name: resourceName}, obj)).To(Succeed())
+
+			Expect(obj.GetAnnotations()).To(HaveKeyWithValue(tektonDeprecated, "true"))
+		},
+			Entry("Pipelines", &pipelinev1.Pipeline{}),
+			Entry("Tasks", &pipelinev1.Task{}),
+		)
+
+		DescribeTable("should delete resource on Cleanup", func(obj client.Object) {
+			cleanupUntilDeleted(operand, request)
+
+			err := request.Client.Get(request.Context, client.ObjectKey{Namespace: namespace, Name: resourceName}, obj)
+			Expect(err).To(MatchError(errors.IsNotFound, "errors.IsNotFound"))
+		},
+			Entry("Pipelines", &pipelinev1.Pipeline{}),
+			Entry("Tasks", &pipelinev1.Task{}),
+		)
 	})
 
 	Context("with old Tasks resources in cluster", func() {
@@ -165,10 +353,9 @@ var _ = Describe("tekton-cleanup operand", func() {
 		)
 
 		DescribeTable("should delete resource on Cleanup", func(obj client.Object) {
-			_, err := operand.Cleanup(request)
-			Expect(err).ToNot(HaveOccurred())
+			cleanupUntilDeleted(operand, request)
 
-			err = request.Client.Get(request.Context, client.ObjectKey{Namespace: namespace, Name: resourceName}, obj)
+			err := request.Client.Get(request.Context, client.ObjectKey{Namespace: namespace, Name: resourceName}, obj)
 			Expect(err).To(MatchError(errors.IsNotFound, "errors.IsNotFound"))
 		},
 			Entry("ClusterRoles", &rbac.ClusterRole{}),
@@ -184,16 +371,38 @@ func TestTektonCleanup(t *testing.T) {
 	RunSpecs(t, "Tekton Cleanup Suite")
 }
 
+func cleanupUntilDeleted(operand operands.Operand, request *common.Request) {
+	// Each call deletes at most one group of resources
+	for i := 0; i < 10; i++ {
+		results, err := operand.Cleanup(request)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+
+		allDeleted := true
+		for _, result := range results {
+			allDeleted = allDeleted && result.Deleted
+		}
+		if allDeleted {
+			return
+		}
+	}
+	Fail("Cleanup did not finish")
+}
+
 func getMockedRequest() *common.Request {
+	return getMockedRequestWithRESTMapper(nil)
+}
+
+func getMockedRequestWithRESTMapper(restMapper meta.RESTMapper) *common.Request {
 	log := logf.Log.WithName("tekton-pipelines-operand")
 
 	Expect(internalmeta.AddToScheme(scheme.Scheme)).To(Succeed())
 	Expect(extv1.AddToScheme(scheme.Scheme)).To(Succeed())
 	Expect(common.AddConversionFunctions(scheme.Scheme)).To(Succeed())
 	Expect(pipeline.AddToScheme(scheme.Scheme)).To(Succeed())
+	Expect(pipelinev1.AddToScheme(scheme.Scheme)).To(Succeed())
 	Expect(ssp.AddToScheme(scheme.Scheme)).To(Succeed())
 
-	client := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRESTMapper(restMapper).Build()
 
 	tektonCrdObj := &extv1.CustomResourceDefinition{
 		TypeMeta: metav1.TypeMeta{
@@ -233,7 +442,7 @@ func getMockedRequest() *common.Request {
 			Spec: ssp.SSPSpec{},
 		},
 		Logger:       log,
-		VersionCache: common.VersionCache{},
+		VersionCache: common.NewVersionCache(),
 		CrdList:      crdWatch,
 	}
 }
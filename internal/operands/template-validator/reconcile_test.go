@@ -73,7 +73,7 @@ var _ = Describe("Template validator operand", func() {
 				},
 			},
 			Logger:       log,
-			VersionCache: common.VersionCache{},
+			VersionCache: common.NewVersionCache(),
 		}
 	})
 
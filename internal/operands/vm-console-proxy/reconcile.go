@@ -8,6 +8,7 @@ import (
 	apps "k8s.io/api/apps/v1"
 	core "k8s.io/api/core/v1"
 	rbac "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	apiregv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	"kubevirt.io/ssp-operator/internal/common"
@@ -145,7 +146,14 @@ func (v *vmConsoleProxy) Reconcile(request *common.Request) ([]common.ReconcileR
 	if err != nil {
 		return nil, err
 	}
-	for _, cleanupResult := range routeCleanupResults {
+
+	// ConfigMaps from previous bundle versions are no longer needed.
+	configMapCleanupResults, err := v.deleteStaleConfigMaps(request)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cleanupResult := range append(routeCleanupResults, configMapCleanupResults...) {
 		if !cleanupResult.Deleted {
 			reconcileResults = append(reconcileResults, common.ResourceDeletedResult(cleanupResult.Resource, common.OperationResultDeleted))
 		}
@@ -240,6 +248,30 @@ func (v *vmConsoleProxy) deleteRoute(request *common.Request) ([]common.CleanupR
 	return common.DeleteAll(request, routes...)
 }
 
+// deleteStaleConfigMaps removes ConfigMaps created by this operand that are not part of the current bundle.
+// They can be left behind when a ConfigMap is renamed or removed in a newer bundle version.
+func (v *vmConsoleProxy) deleteStaleConfigMaps(request *common.Request) ([]common.CleanupResult, error) {
+	configMaps, err := listResourcesUsingLabels(
+		request.Context,
+		request.Client,
+		func(list *core.ConfigMapList) []core.ConfigMap { return list.Items },
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	currentKey := client.ObjectKey{Namespace: request.Instance.Namespace, Name: v.configMap.Name}
+
+	var staleConfigMaps []client.Object
+	for _, configMap := range configMaps {
+		if client.ObjectKeyFromObject(configMap) != currentKey {
+			staleConfigMaps = append(staleConfigMaps, configMap)
+		}
+	}
+
+	return common.DeleteAll(request, staleConfigMaps...)
+}
+
 func reconcileServiceAccount(serviceAccount core.ServiceAccount) common.ReconcileFunc {
 	return func(request *common.Request) (common.ReconcileResult, error) {
 		serviceAccount.Namespace = request.Instance.Namespace
@@ -251,6 +283,7 @@ func reconcileServiceAccount(serviceAccount core.ServiceAccount) common.Reconcil
 }
 
 func reconcileClusterRole(clusterRole rbac.ClusterRole) common.ReconcileFunc {
+	clusterRole.Rules = deduplicatePolicyRules(clusterRole.Rules)
 	return func(request *common.Request) (common.ReconcileResult, error) {
 		return common.CreateOrUpdate(request).
 			ClusterResource(&clusterRole).
@@ -259,6 +292,25 @@ func reconcileClusterRole(clusterRole rbac.ClusterRole) common.ReconcileFunc {
 	}
 }
 
+// deduplicatePolicyRules removes rules that are equal to an earlier rule.
+// The order of the remaining rules is kept.
+func deduplicatePolicyRules(rules []rbac.PolicyRule) []rbac.PolicyRule {
+	result := make([]rbac.PolicyRule, 0, len(rules))
+	for _, rule := range rules {
+		isDuplicate := false
+		for i := range result {
+			if equality.Semantic.DeepEqual(rule, result[i]) {
+				isDuplicate = true
+				break
+			}
+		}
+		if !isDuplicate {
+			result = append(result, rule)
+		}
+	}
+	return result
+}
+
 func reconcileClusterRoleBinding(clusterRoleBinding rbac.ClusterRoleBinding) common.ReconcileFunc {
 	return func(request *common.Request) (common.ReconcileResult, error) {
 		clusterRoleBinding.Subjects[0].Namespace = request.Instance.Namespace
@@ -341,6 +393,30 @@ func findResourcesUsingLabels[PtrL interface {
 	*T
 	client.Object
 }, L any, T any](ctx context.Context, name string, cli client.Client, itemsFunc func(list PtrL) []T) ([]client.Object, error) {
+	items, err := listResourcesUsingLabels[PtrL, PtrT](ctx, cli, itemsFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Filtering in a loop instead of using a FieldSelector in the List() call.
+	// It is only slightly inefficient, because all objects are already cached locally, so there is no API call.
+	// Adding an Indexer to the cache for each object type that we want to list here would be a larger change.
+	var filteredItems []client.Object
+	for _, item := range items {
+		if item.GetName() == name {
+			filteredItems = append(filteredItems, item)
+		}
+	}
+	return filteredItems, nil
+}
+
+func listResourcesUsingLabels[PtrL interface {
+	*L
+	client.ObjectList
+}, PtrT interface {
+	*T
+	client.Object
+}, L any, T any](ctx context.Context, cli client.Client, itemsFunc func(list PtrL) []T) ([]client.Object, error) {
 	listObj := PtrL(new(L))
 	err := cli.List(ctx, listObj,
 		client.MatchingLabels{
@@ -353,16 +429,10 @@ func findResourcesUsingLabels[PtrL interface {
 		return nil, fmt.Errorf("error listing objects: %w", err)
 	}
 
-	// Filtering in a loop instead of using a FieldSelector in the List() call.
-	// It is only slightly inefficient, because all objects are already cached locally, so there is no API call.
-	// Adding an Indexer to the cache for each object type that we want to list here would be a larger change.
 	items := itemsFunc(listObj)
-	var filteredItems []client.Object
+	objects := make([]client.Object, 0, len(items))
 	for i := range items {
-		item := PtrT(&items[i])
-		if item.GetName() == name {
-			filteredItems = append(filteredItems, item)
-		}
+		objects = append(objects, PtrT(&items[i]))
 	}
-	return filteredItems, nil
+	return objects, nil
 }
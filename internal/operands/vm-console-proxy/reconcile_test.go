@@ -84,6 +84,20 @@ var _ = Describe("VM Console Proxy Operand", func() {
 		ExpectResourceExists(bundle.ApiService, request)
 	})
 
+	It("should remove duplicate rules from ClusterRole", func() {
+		clusterRole := &bundle.ClusterRoles[0]
+		originalRules := clusterRole.DeepCopy().Rules
+		clusterRole.Rules = append(clusterRole.Rules, *originalRules[0].DeepCopy(), *originalRules[1].DeepCopy())
+
+		operand = New(bundle)
+		_, err := operand.Reconcile(&request)
+		Expect(err).ToNot(HaveOccurred())
+
+		foundClusterRole := &rbac.ClusterRole{}
+		Expect(request.Client.Get(request.Context, client.ObjectKeyFromObject(clusterRole), foundClusterRole)).To(Succeed())
+		Expect(foundClusterRole.Rules).To(Equal(originalRules))
+	})
+
 	It("should read deployment image the environment variable", func() {
 		originalImage := os.Getenv(common.VmConsoleProxyImageKey)
 
@@ -177,6 +191,64 @@ var _ = Describe("VM Console Proxy Operand", func() {
 		}),
 	)
 
+	Context("with ConfigMaps from previous bundle version", func() {
+		var staleConfigMap *core.ConfigMap
+
+		BeforeEach(func() {
+			staleConfigMap = &core.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      configMapName + "-old",
+					Namespace: namespace,
+					Annotations: map[string]string{
+						libhandler.TypeAnnotation:           "SSP.ssp.kubevirt.io",
+						libhandler.NamespacedNameAnnotation: namespace + "/" + name,
+					},
+					Labels: map[string]string{
+						common.AppKubernetesNameLabel:      operandName,
+						common.AppKubernetesComponentLabel: operandComponent,
+						common.AppKubernetesManagedByLabel: common.AppKubernetesManagedByValue,
+					},
+				},
+			}
+			Expect(request.Client.Create(request.Context, staleConfigMap)).To(Succeed())
+		})
+
+		It("should delete stale ConfigMap on reconcile", func() {
+			_, err := operand.Reconcile(&request)
+			Expect(err).ToNot(HaveOccurred())
+
+			ExpectResourceExists(bundle.ConfigMap, request)
+			ExpectResourceNotExists(staleConfigMap.DeepCopy(), request)
+		})
+
+		It("should delete stale ConfigMap with current name in other namespace", func() {
+			otherNamespaceConfigMap := staleConfigMap.DeepCopy()
+			otherNamespaceConfigMap.Name = bundle.ConfigMap.Name
+			otherNamespaceConfigMap.Namespace = namespace + "-other"
+			otherNamespaceConfigMap.ResourceVersion = ""
+			Expect(request.Client.Create(request.Context, otherNamespaceConfigMap)).To(Succeed())
+
+			_, err := operand.Reconcile(&request)
+			Expect(err).ToNot(HaveOccurred())
+
+			ExpectResourceExists(bundle.ConfigMap, request)
+			ExpectResourceNotExists(otherNamespaceConfigMap, request)
+		})
+
+		It("should not delete ConfigMap not owned by SSP", func() {
+			foreignConfigMap := staleConfigMap.DeepCopy()
+			foreignConfigMap.Name = configMapName + "-foreign"
+			foreignConfigMap.ResourceVersion = ""
+			foreignConfigMap.Annotations = nil
+			Expect(request.Client.Create(request.Context, foreignConfigMap)).To(Succeed())
+
+			_, err := operand.Reconcile(&request)
+			Expect(err).ToNot(HaveOccurred())
+
+			ExpectResourceExists(foreignConfigMap, request)
+		})
+	})
+
 	It("should not update service cluster IP", func() {
 		_, err := operand.Reconcile(&request)
 		Expect(err).ToNot(HaveOccurred())
@@ -315,7 +387,7 @@ func getMockedRequest() common.Request {
 			},
 		},
 		Logger:       log,
-		VersionCache: common.VersionCache{},
+		VersionCache: common.NewVersionCache(),
 	}
 }
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"slices"
 
 	templatev1 "github.com/openshift/api/template/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -13,13 +14,41 @@ import (
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 )
 
+const templateKind = "Template"
+
+// UnknownKindPolicy specifies how resources of unsupported kinds in the bundle are handled.
+type UnknownKindPolicy string
+
+const (
+	// UnknownKindIgnore skips resources of unsupported kinds.
+	UnknownKindIgnore UnknownKindPolicy = "ignore"
+	// UnknownKindWarn skips resources of unsupported kinds and lists their kinds in Bundle.UnknownKinds.
+	UnknownKindWarn UnknownKindPolicy = "warn"
+	// UnknownKindFail fails reading the bundle if it contains a resource of unsupported kind.
+	UnknownKindFail UnknownKindPolicy = "fail"
+)
+
+func ParseUnknownKindPolicy(value string) (UnknownKindPolicy, error) {
+	switch policy := UnknownKindPolicy(value); policy {
+	case UnknownKindIgnore, UnknownKindWarn, UnknownKindFail:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("invalid unknown kind policy: %s, supported values are: %s, %s, %s",
+			value, UnknownKindIgnore, UnknownKindWarn, UnknownKindFail)
+	}
+}
+
 type Bundle struct {
 	Templates   []templatev1.Template
 	DataSources []cdiv1beta1.DataSource
+
+	// UnknownKinds lists the unsupported kinds that were skipped,
+	// if the bundle was read with UnknownKindWarn policy.
+	UnknownKinds []string
 }
 
-func ReadBundle(filename string) (Bundle, error) {
-	templates, err := readTemplates(filename)
+func ReadBundle(filename string, unknownKindPolicy UnknownKindPolicy) (Bundle, error) {
+	templates, unknownKinds, err := readTemplates(filename, unknownKindPolicy)
 	if err != nil {
 		return Bundle{}, err
 	}
@@ -30,30 +59,44 @@ func ReadBundle(filename string) (Bundle, error) {
 	}
 
 	return Bundle{
-		Templates:   templates,
-		DataSources: sources,
+		Templates:    templates,
+		DataSources:  sources,
+		UnknownKinds: unknownKinds,
 	}, nil
 }
 
-func readTemplates(filename string) ([]templatev1.Template, error) {
+func readTemplates(filename string, unknownKindPolicy UnknownKindPolicy) ([]templatev1.Template, []string, error) {
 	var bundle []templatev1.Template
+	var unknownKinds []string
 	file, err := os.ReadFile(filename)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(file), 1024)
 	for {
 		template := templatev1.Template{}
 		err = decoder.Decode(&template)
 		if err == io.EOF {
-			return bundle, nil
+			return bundle, unknownKinds, nil
 		}
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
-		if template.Name != "" {
-			bundle = append(bundle, template)
+		if template.Name == "" {
+			continue
+		}
+		if template.Kind != templateKind {
+			switch unknownKindPolicy {
+			case UnknownKindFail:
+				return nil, nil, fmt.Errorf("unsupported Kind found in template bundle: %s, %s", template.Name, template.Kind)
+			case UnknownKindWarn:
+				if !slices.Contains(unknownKinds, template.Kind) {
+					unknownKinds = append(unknownKinds, template.Kind)
+				}
+			}
+			continue
 		}
+		bundle = append(bundle, template)
 	}
 }
 
@@ -1,6 +1,8 @@
 package template_bundle
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -14,7 +16,7 @@ var _ = Describe("Template bundle", Ordered, func() {
 
 	BeforeAll(func() {
 		var err error
-		testBundle, err = ReadBundle("template-bundle-test.yaml")
+		testBundle, err = ReadBundle("template-bundle-test.yaml", UnknownKindFail)
 		Expect(err).ToNot(HaveOccurred())
 	})
 
@@ -66,6 +68,75 @@ var _ = Describe("Template bundle", Ordered, func() {
 	})
 })
 
+var _ = Describe("Template bundle with unknown kind", func() {
+	var bundlePath string
+
+	BeforeEach(func() {
+		const bundleContent = `---
+apiVersion: template.openshift.io/v1
+kind: Template
+metadata:
+  name: known-kind
+objects:
+- apiVersion: kubevirt.io/v1
+  kind: VirtualMachine
+  metadata:
+    name: test-vm
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: unknown-kind
+data:
+  key: value
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: unknown-kind-2
+data:
+  key: value
+`
+		bundlePath = filepath.Join(GinkgoT().TempDir(), "template-bundle-unknown-kind.yaml")
+		Expect(os.WriteFile(bundlePath, []byte(bundleContent), 0644)).To(Succeed())
+	})
+
+	It("should fail to read bundle with fail policy", func() {
+		_, err := ReadBundle(bundlePath, UnknownKindFail)
+		Expect(err).To(MatchError(ContainSubstring("unsupported Kind found in template bundle: unknown-kind, ConfigMap")))
+	})
+
+	It("should skip and list unknown kinds with warn policy", func() {
+		bundle, err := ReadBundle(bundlePath, UnknownKindWarn)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bundle.Templates).To(HaveLen(1))
+		Expect(bundle.Templates[0].Name).To(Equal("known-kind"))
+		Expect(bundle.UnknownKinds).To(Equal([]string{"ConfigMap"}))
+	})
+
+	It("should skip unknown kinds with ignore policy", func() {
+		bundle, err := ReadBundle(bundlePath, UnknownKindIgnore)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bundle.Templates).To(HaveLen(1))
+		Expect(bundle.UnknownKinds).To(BeEmpty())
+	})
+
+	DescribeTable("ParseUnknownKindPolicy", func(value string, expected UnknownKindPolicy, valid bool) {
+		policy, err := ParseUnknownKindPolicy(value)
+		if !valid {
+			Expect(err).To(HaveOccurred())
+			return
+		}
+		Expect(err).ToNot(HaveOccurred())
+		Expect(policy).To(Equal(expected))
+	},
+		Entry("ignore", "ignore", UnknownKindIgnore, true),
+		Entry("warn", "warn", UnknownKindWarn, true),
+		Entry("fail", "fail", UnknownKindFail, true),
+		Entry("invalid", "invalid", UnknownKindPolicy(""), false),
+	)
+})
+
 func TestTemplateBundle(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Template Bundle Suite")
@@ -44,6 +44,7 @@ import (
 	ssp "kubevirt.io/ssp-operator/api/v1beta2"
 	"kubevirt.io/ssp-operator/controllers"
 	"kubevirt.io/ssp-operator/internal/common"
+	template_bundle "kubevirt.io/ssp-operator/internal/template-bundle"
 	sspMetrics "kubevirt.io/ssp-operator/pkg/monitoring/metrics/ssp-operator"
 	"kubevirt.io/ssp-operator/pkg/monitoring/rules"
 	"kubevirt.io/ssp-operator/webhooks"
@@ -203,18 +204,33 @@ func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
+	var readOnly bool
+	var unknownBundleKindPolicy string
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8443", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.BoolVar(&readOnly, "read-only", false,
+		"Run the operator in read-only mode. "+
+			"Operands, the metrics service and the webhook configuration are reconciled, "+
+			"but all changes to them are only sent as dry-run requests. The SSP status is still updated.")
+	flag.StringVar(&unknownBundleKindPolicy, "unknown-bundle-kind-policy", string(template_bundle.UnknownKindWarn),
+		"How to handle resources of unsupported kinds in the template bundle. "+
+			"Supported values are: ignore, warn (skip and report them in the SSP status) and fail.")
 	opts := zap.Options{}
 	opts.BindFlags(flag.CommandLine)
 	flag.Parse()
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
-	err := createCertificateSymlinks()
+	bundleKindPolicy, err := template_bundle.ParseUnknownKindPolicy(unknownBundleKindPolicy)
+	if err != nil {
+		setupLog.Error(err, "Invalid value of unknown-bundle-kind-policy flag")
+		os.Exit(1)
+	}
+
+	err = createCertificateSymlinks()
 	if err != nil {
 		setupLog.Error(err, "Error creating certificate symlinks")
 		os.Exit(1)
@@ -278,7 +294,10 @@ func main() {
 	}
 
 	// +kubebuilder:scaffold:builder
-	if err = controllers.CreateAndStartReconciler(ctx, mgr); err != nil {
+	if err = controllers.CreateAndStartReconciler(ctx, mgr, controllers.Options{
+		ReadOnly:                readOnly,
+		UnknownBundleKindPolicy: bundleKindPolicy,
+	}); err != nil {
 		setupLog.Error(err, "unable to create or start controller", "controller", "SSP")
 		os.Exit(1)
 	}
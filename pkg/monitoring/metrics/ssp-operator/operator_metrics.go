@@ -1,10 +1,15 @@
 package metrics
 
-import "github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+import (
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
 
 var (
 	operatorMetrics = []operatormetrics.Metric{
 		sspOperatorReconcileSucceeded,
+		sspOperatorLastReconcileTimestamp,
+		sspOperatorManagedResources,
 	}
 
 	sspOperatorReconcileSucceeded = operatormetrics.NewGauge(
@@ -13,6 +18,22 @@ var (
 			Help: "Set to 1 if the reconcile process of all operands completes with no errors, and to 0 otherwise",
 		},
 	)
+
+	sspOperatorLastReconcileTimestamp = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_ssp_operator_last_reconcile_timestamp_seconds",
+			Help: "The Unix timestamp of the last reconcile of an operand",
+		},
+		[]string{"operand"},
+	)
+
+	sspOperatorManagedResources = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_ssp_operator_managed_resources",
+			Help: "The number of resources managed by an operand, per resource kind",
+		},
+		[]string{"operand", "kind"},
+	)
 )
 
 func SetSspOperatorReconcileSucceeded(isSucceeded bool) {
@@ -22,3 +43,16 @@ func SetSspOperatorReconcileSucceeded(isSucceeded bool) {
 	}
 	sspOperatorReconcileSucceeded.Set(value)
 }
+
+func SetSspOperatorLastReconcileTimestamp(operand string) {
+	sspOperatorLastReconcileTimestamp.WithLabelValues(operand).SetToCurrentTime()
+}
+
+// SetSspOperatorManagedResources replaces the counts of resources managed by the operand.
+// Kinds that are not in countsByKind are removed.
+func SetSspOperatorManagedResources(operand string, countsByKind map[string]int) {
+	sspOperatorManagedResources.DeletePartialMatch(prometheus.Labels{"operand": operand})
+	for kind, count := range countsByKind {
+		sspOperatorManagedResources.WithLabelValues(operand, kind).Set(float64(count))
+	}
+}
@@ -0,0 +1,80 @@
+package metrics
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	ioprometheusclient "github.com/prometheus/client_model/go"
+)
+
+var _ = Describe("operator_metrics", func() {
+	const operandName = "test-operand"
+
+	BeforeEach(func() {
+		sspOperatorLastReconcileTimestamp.Reset()
+	})
+
+	getLastReconcileTimestamp := func(operand string) float64 {
+		dto := &ioprometheusclient.Metric{}
+		err := sspOperatorLastReconcileTimestamp.WithLabelValues(operand).Write(dto)
+		Expect(err).ToNot(HaveOccurred())
+		return dto.GetGauge().GetValue()
+	}
+
+	It("should set last reconcile timestamp to current time", func() {
+		before := float64(time.Now().UnixNano()) / 1e9
+		SetSspOperatorLastReconcileTimestamp(operandName)
+		after := float64(time.Now().UnixNano()) / 1e9
+
+		Expect(getLastReconcileTimestamp(operandName)).To(And(
+			BeNumerically(">=", before),
+			BeNumerically("<=", after),
+		))
+	})
+
+	It("should update last reconcile timestamp on every reconcile", func() {
+		SetSspOperatorLastReconcileTimestamp(operandName)
+		first := getLastReconcileTimestamp(operandName)
+
+		time.Sleep(10 * time.Millisecond)
+
+		SetSspOperatorLastReconcileTimestamp(operandName)
+		Expect(getLastReconcileTimestamp(operandName)).To(BeNumerically(">", first))
+	})
+
+	It("should track operands separately", func() {
+		SetSspOperatorLastReconcileTimestamp(operandName)
+		Expect(getLastReconcileTimestamp("other-operand")).To(BeZero())
+	})
+
+	Context("managed resources", func() {
+		BeforeEach(func() {
+			sspOperatorManagedResources.Reset()
+		})
+
+		getManagedResources := func(operand, kind string) float64 {
+			dto := &ioprometheusclient.Metric{}
+			err := sspOperatorManagedResources.WithLabelValues(operand, kind).Write(dto)
+			Expect(err).ToNot(HaveOccurred())
+			return dto.GetGauge().GetValue()
+		}
+
+		It("should set counts per kind", func() {
+			SetSspOperatorManagedResources(operandName, map[string]int{"ConfigMap": 2, "ClusterRole": 1})
+			Expect(getManagedResources(operandName, "ConfigMap")).To(Equal(float64(2)))
+			Expect(getManagedResources(operandName, "ClusterRole")).To(Equal(float64(1)))
+		})
+
+		It("should remove kinds no longer managed", func() {
+			SetSspOperatorManagedResources(operandName, map[string]int{"ConfigMap": 2, "ClusterRole": 1})
+			SetSspOperatorManagedResources("other-operand", map[string]int{"ConfigMap": 3})
+			SetSspOperatorManagedResources(operandName, map[string]int{"ConfigMap": 1})
+
+			Expect(sspOperatorManagedResources.DeleteLabelValues(operandName, "ClusterRole")).To(BeFalse(), "ClusterRole count should be removed")
+			Expect(getManagedResources(operandName, "ConfigMap")).To(Equal(float64(1)))
+			Expect(getManagedResources("other-operand", "ConfigMap")).To(Equal(float64(3)))
+		})
+	})
+})